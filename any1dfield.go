@@ -40,7 +40,12 @@ func (f *Any1DField) Get() []Primitive {
 	return f.ary
 }
 
+// Sets the primitives.  Nothing is sent to the App if these are the very same primitives as
+// before, unless it's the same slice, since it might have been modified in place.
 func (f *Any1DField) Set(ary []Primitive) {
+	if equalSlices(ary, f.ary) {
+		return
+	}
 	f.unprepareDescendantsForUpdates()
 	f.ary = ary
 	f.prepareDescendantsForUpdates()
@@ -234,3 +234,20 @@ func Test_Any1DIngestUpdateInvalidNumPrimitives(t *testing.T) {
 		t.Fatalf("wrong error was returned:  %s", err.Error())
 	}
 }
+
+func Test_Any1DSetSamePrimitives(t *testing.T) {
+	f := Any1DField{}
+	actuals_i, _ := generateTestData1D()
+	f.Set(actuals_i)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set(append([]Primitive{}, actuals_i...))
+	if testOnsetCalled {
+		t.Error("onset was called even though the primitives didn't change")
+	}
+
+	f.Set(actuals_i[:2])
+	if !testOnsetCalled {
+		t.Error("onset was not called when the primitives changed")
+	}
+}
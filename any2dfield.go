@@ -52,7 +52,13 @@ func (f *Any2DField) Get() [][]Primitive {
 	return f.ary
 }
 
+// Sets the rows of primitives.  Nothing is sent to the App if these are the very same
+// primitives as before, unless the same slices are used, since they might have been modified
+// in place.
 func (f *Any2DField) Set(ary [][]Primitive) {
+	if equalRows(ary, f.ary) {
+		return
+	}
 	f.unprepareDescendantsForUpdates()
 	f.ary = ary
 	f.prepareDescendantsForUpdates()
@@ -125,3 +131,19 @@ func (f *Any2DField) IngestValue(value any) error {
 	return nil
 
 }
+
+// Returns true if two sets of rows have the very same primitives.
+func equalRows(a, b [][]Primitive) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) > 0 && &a[0] == &b[0] {
+		return false
+	}
+	for i := range a {
+		if !equalSlices(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
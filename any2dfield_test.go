@@ -283,3 +283,20 @@ func Test_Any2DIngestUpdateInvalidNumCols(t *testing.T) {
 		t.Fatalf("wrong error was returned:  %s", err.Error())
 	}
 }
+
+func Test_Any2DSetSamePrimitives(t *testing.T) {
+	f := Any2DField{}
+	actuals_i, _ := generateTestData2D()
+	f.Set(actuals_i)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set([][]Primitive{append([]Primitive{}, actuals_i[0]...), append([]Primitive{}, actuals_i[1]...)})
+	if testOnsetCalled {
+		t.Error("onset was called even though the primitives didn't change")
+	}
+
+	f.Set([][]Primitive{actuals_i[1], actuals_i[0]})
+	if !testOnsetCalled {
+		t.Error("onset was not called when the primitives changed")
+	}
+}
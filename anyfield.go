@@ -38,6 +38,9 @@ func (f *AnyField) Get() Primitive {
 }
 
 func (f *AnyField) Set(p Primitive) {
+	if p == f.p {
+		return
+	}
 	f.unprepareDescendantForUpdates()
 	f.p = p
 	f.prepareDescendantForUpdates()
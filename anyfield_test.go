@@ -150,3 +150,20 @@ func Test_AnyIngestUpdateInvalid2(t *testing.T) {
 		t.Fatalf("wrong error was returned:  %s", err.Error())
 	}
 }
+
+func Test_AnySetSamePrimitive(t *testing.T) {
+	f := AnyField{}
+	p := &TestPrimitive{s: "abc"}
+	f.Set(p)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set(p)
+	if testOnsetCalled {
+		t.Error("onset was called even though the primitive didn't change")
+	}
+
+	f.Set(&TestPrimitive{s: "abc"})
+	if !testOnsetCalled {
+		t.Error("onset was not called when the primitive changed")
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// An axis of a LineChart, BarChart, or ScatterChart.
+type AxisWith struct {
+	Categories []string
+	Embodiment string
+	Label      string
+	MaxValue   float64
+	MinValue   float64
+	Tag        string
+}
+
+// Makes a new Axis with specified field values.
+func (w AxisWith) Make() *Axis {
+	axis := &Axis{}
+	axis.categories.Set(w.Categories)
+	axis.embodiment.Set(w.Embodiment)
+	axis.label.Set(w.Label)
+	axis.maxValue.Set(w.MaxValue)
+	axis.minValue.Set(w.MinValue)
+	axis.tag.Set(w.Tag)
+	return axis
+}
+
+// An axis of a LineChart, BarChart, or ScatterChart, with a label.  The range of the axis
+// fits the points shown unless MaxValue is greater than MinValue.
+//
+// An axis with categories, such as the months of the year, shows the name of a category in
+// place of each whole number from 0.  This is typically used for the X axis of a BarChart.
+type Axis struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	categories String1DField
+	embodiment StringField
+	label      StringField
+	maxValue   FloatField
+	minValue   FloatField
+	tag        StringField
+}
+
+// Creates a new axis with a label.
+func NewAxis(label string) *Axis {
+	return AxisWith{Label: label}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (axis *Axis) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	axis.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Categories, &axis.categories},
+			{key.FKey_Embodiment, &axis.embodiment},
+			{key.FKey_Label, &axis.label},
+			{key.FKey_MaxValue, &axis.maxValue},
+			{key.FKey_MinValue, &axis.minValue},
+			{key.FKey_Tag, &axis.tag},
+		}
+	})
+}
+
+// Returns a string representation of this primitive:  the label.
+// Implements of fmt:Stringer interface.
+func (axis *Axis) String() string {
+	return axis.label.Get()
+}
+
+// Returns the names of the categories shown in place of whole numbers from 0.
+func (axis *Axis) Categories() []string {
+	return axis.categories.Get()
+}
+
+// Sets the names of the categories shown in place of whole numbers from 0.
+func (axis *Axis) SetCategories(categories []string) *Axis {
+	axis.categories.Set(categories)
+	return axis
+}
+
+// Sets the names of the categories (variadic argument list) shown in place of whole numbers
+// from 0.
+func (axis *Axis) SetCategoriesVA(categories ...string) *Axis {
+	axis.categories.Set(categories)
+	return axis
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (axis *Axis) Embodiment() string {
+	return axis.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (axis *Axis) SetEmbodiment(s string) *Axis {
+	axis.embodiment.Set(s)
+	return axis
+}
+
+// Returns the label of the axis.
+func (axis *Axis) Label() string {
+	return axis.label.Get()
+}
+
+// Sets the label of the axis.
+func (axis *Axis) SetLabel(s string) *Axis {
+	axis.label.Set(s)
+	return axis
+}
+
+// Returns the lowest value shown on the axis.
+func (axis *Axis) Min() float64 {
+	return axis.minValue.Get()
+}
+
+// Sets the lowest value shown on the axis.  The range fits the points shown unless the
+// highest value is greater.
+func (axis *Axis) SetMin(v float64) *Axis {
+	axis.minValue.Set(v)
+	return axis
+}
+
+// Returns the highest value shown on the axis.
+func (axis *Axis) Max() float64 {
+	return axis.maxValue.Get()
+}
+
+// Sets the highest value shown on the axis.  The range fits the points shown unless this is
+// greater than the lowest value.
+func (axis *Axis) SetMax(v float64) *Axis {
+	axis.maxValue.Set(v)
+	return axis
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (axis *Axis) Tag() string {
+	return axis.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (axis *Axis) SetTag(s string) *Axis {
+	axis.tag.Set(s)
+	return axis
+}
@@ -0,0 +1,164 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A chart showing each point of each series as a bar from zero to its Y value.
+type BarChartWith struct {
+	BarSeries  []*ChartSeries
+	Embodiment string
+	Legend     bool
+	Status     int
+	Tag        string
+	Title      string
+	WindowSize int
+	XAxis      *Axis
+	YAxis      *Axis
+}
+
+// Makes a new BarChart with specified field values.
+func (w BarChartWith) Make() *BarChart {
+	chart := &BarChart{}
+	chart.embodiment.Set(w.Embodiment)
+	chart.legend.Set(w.Legend)
+	chart.setWindowSize(w.WindowSize)
+	chart.setChartSeries(w.BarSeries)
+	chart.status.Set(w.Status)
+	chart.tag.Set(w.Tag)
+	chart.title.Set(w.Title)
+	chart.SetXAxis(w.XAxis)
+	chart.SetYAxis(w.YAxis)
+	return chart
+}
+
+// A chart showing each point of each series as a bar from zero to its Y value.  Use an X axis
+// with categories to name the bars, giving each point the index of its category as X.  The
+// label of each series is shown in the legend, if enabled.
+//
+// Points can be streamed to the App using AddPoint, which only sends the new points to Apps
+// that support it.  Set a window size to keep only the most recent points of each series, such
+// as for a live dashboard.
+type BarChart struct {
+	// Mix-in the guts shared by charts
+	chartBase
+}
+
+// Creates a new BarChart with a title and its series.
+func NewBarChart(title string, series ...*ChartSeries) *BarChart {
+	return BarChartWith{Title: title, BarSeries: series}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (chart *BarChart) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+	chart.prepareForUpdates(key.FKey_BarSeries, pkey, onset, etsprovider)
+}
+
+// Returns the series shown in the chart.
+func (chart *BarChart) BarSeries() []*ChartSeries {
+	return chart.chartSeries()
+}
+
+// Sets the series shown in the chart.  The window size of the chart is applied to each series.
+func (chart *BarChart) SetBarSeries(series []*ChartSeries) *BarChart {
+	chart.setChartSeries(series)
+	return chart
+}
+
+// Sets the series (variadic argument list) shown in the chart.  The window size of the chart is
+// applied to each series.
+func (chart *BarChart) SetBarSeriesVA(series ...*ChartSeries) *BarChart {
+	return chart.SetBarSeries(series)
+}
+
+// Sets the maximum number of points kept in each series, or 0 for no maximum.  The oldest
+// points are dropped once there are more.  A negative size is taken as 0.
+func (chart *BarChart) SetWindowSize(n int) *BarChart {
+	chart.setWindowSize(n)
+	return chart
+}
+
+// Sets the title shown above the chart.
+func (chart *BarChart) SetTitle(s string) *BarChart {
+	chart.title.Set(s)
+	return chart
+}
+
+// Sets whether a legend with the label of each series is shown.
+func (chart *BarChart) SetLegend(b bool) *BarChart {
+	chart.legend.Set(b)
+	return chart
+}
+
+// Sets the X axis, or nil to let the App decide how to show it.
+func (chart *BarChart) SetXAxis(axis *Axis) *BarChart {
+	chart.xAxis.Set(axisPrimitive(axis))
+	return chart
+}
+
+// Sets the Y axis, or nil to let the App decide how to show it.
+func (chart *BarChart) SetYAxis(axis *Axis) *BarChart {
+	chart.yAxis.Set(axisPrimitive(axis))
+	return chart
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (chart *BarChart) SetEmbodiment(s string) *BarChart {
+	chart.embodiment.Set(s)
+	return chart
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (chart *BarChart) SetTag(s string) *BarChart {
+	chart.tag.Set(s)
+	return chart
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *BarChart) SetStatus(i int) *BarChart {
+	p.status.Set(i)
+	return p
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *BarChart) SetVisible(visible bool) *BarChart {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *BarChart) SetEnabled(enabled bool) *BarChart {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *BarChart) SetCollapsed(collapsed bool) *BarChart {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
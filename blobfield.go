@@ -7,32 +7,84 @@
 package golib
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"os"
 
+	cbor "github.com/fxamacker/cbor/v2"
 	"github.com/prontogui/golib/key"
 )
 
+// Blobs at least this large are handed off to the Synchro when egested so they can be
+// transferred in chunks or sent by reference to the App's cache.  Smaller blobs are always
+// egested as-is.
+const minTransferBlobSize = 16 * 1024
+
+// Names of the items in a map that represents a chunk of blob data.  A chunk is used in place
+// of the complete blob when transferring large blobs, in either direction, over successive
+// updates.  The first chunk sent by the server only has the size and hash, and no bytes.
+//
+// A map with only the hash refers to a blob the App already has in its cache.  If the App
+// doesn't have it after all, the App sends the same map back to request the blob.
+const (
+	blobChunkBytes  = "Bytes"
+	blobChunkHash   = "Hash"
+	blobChunkOffset = "Offset"
+	blobChunkSize   = "Size"
+)
+
 type BlobField struct {
 	FieldBase
 	blob []byte
+
+	// The blob being assembled from chunks ingested from the App.
+	incoming []byte
+
+	// Progress of the latest chunked transfer in either direction.
+	transferred  int
+	transferSize int
+
+	// The content hash of the blob, or empty if not calculated yet.
+	hash string
 }
 
 func (f *BlobField) Get() []byte {
 	return f.blob
 }
 
+// Sets the blob.  Nothing is sent to the App if the new blob has the same contents as the
+// current blob, unless it's the same slice, since its contents might have been modified.
 func (f *BlobField) Set(blob []byte) {
-	f.blob = blob
+	if equalSlices(blob, f.blob) {
+		return
+	}
+	f.assign(blob)
 	f.OnSet(false)
 }
 
+func (f *BlobField) assign(blob []byte) {
+	f.blob = blob
+	f.hash = ""
+	f.transferred, f.transferSize = 0, 0
+}
+
+// Returns the SHA-256 hash of the blob's contents as a hex string.  The App caches large
+// blobs by this hash so that the same contents don't have to be sent again.
+func (f *BlobField) Hash() string {
+	if f.hash == "" {
+		sum := sha256.Sum256(f.blob)
+		f.hash = hex.EncodeToString(sum[:])
+	}
+	return f.hash
+}
+
 func (f *BlobField) LoadFromFile(filePath string) error {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return err
 	}
-	f.blob = data
+	f.assign(data)
 	f.OnSet(false)
 	return nil
 }
@@ -50,22 +102,98 @@ func (f *BlobField) SaveToFile(filePath string) error {
 	return err
 }
 
+// Returns the fraction (0 to 1) of the latest chunked transfer that has completed, whether the
+// blob is being sent to the App or received from it.  Returns 1 if a blob was set or ingested
+// without chunking, and 0 if the blob is empty and no transfer has started.
+func (f *BlobField) Progress() float64 {
+	if f.transferSize > 0 {
+		return float64(f.transferred) / float64(f.transferSize)
+	}
+	if len(f.blob) > 0 {
+		return 1
+	}
+	return 0
+}
+
 func (f *BlobField) PrepareForUpdates(fkey key.FKey, pkey key.PKey, fieldPKeyIndex int, onset key.OnSetFunction, etsprovider EventTimestampProvider) (isContainer bool) {
 	f.StashUpdateInfo(fkey, pkey, fieldPKeyIndex, onset, etsprovider)
 	return false
 }
 
 func (f *BlobField) EgestValue() any {
+	if len(f.blob) >= minTransferBlobSize {
+		return &blobEgest{field: f, blob: f.blob}
+	}
 	return f.blob
 }
 
 func (f *BlobField) IngestValue(value any) error {
 
-	bytes, ok := value.([]uint8)
+	switch v := value.(type) {
+	case []uint8:
+		f.assign(v)
+		f.incoming = nil
+		return nil
+	case map[any]any:
+		return f.ingestChunk(v)
+	}
+
+	return errors.New("ingested value type not supported for Blob")
+}
+
+// Ingests the next chunk of a blob that is being transferred from the App.  The chunks must
+// arrive in order and the blob is assigned once the last chunk is ingested.
+func (f *BlobField) ingestChunk(chunk map[any]any) error {
+
+	offset, err := ConvertAnyToInt(chunk[blobChunkOffset])
+	if err != nil {
+		return errors.New("invalid offset in blob chunk")
+	}
+
+	size, err := ConvertAnyToInt(chunk[blobChunkSize])
+	if err != nil || size < 0 {
+		return errors.New("invalid size in blob chunk")
+	}
+
+	bytes, ok := chunk[blobChunkBytes].([]uint8)
 	if !ok {
-		return errors.New("ingested value type not supported for Blob")
+		return errors.New("invalid bytes in blob chunk")
+	}
+
+	if offset == 0 {
+		f.incoming = make([]byte, size)
+		f.transferred, f.transferSize = 0, size
+	}
+
+	if f.incoming == nil || size != f.transferSize || offset != f.transferred {
+		return errors.New("blob chunk is out of sequence")
+	}
+
+	if offset+len(bytes) > size {
+		return errors.New("blob chunk exceeds the size of the blob")
+	}
+
+	copy(f.incoming[offset:], bytes)
+	f.transferred = offset + len(bytes)
+
+	if f.transferred == size {
+		f.blob = f.incoming
+		f.hash = ""
+		f.incoming = nil
 	}
 
-	f.blob = bytes
 	return nil
 }
+
+// A large blob egested from a BlobField.  The Synchro replaces this with the blob itself, a
+// reference to the blob in the App's cache, or the start of a chunked transfer.
+type blobEgest struct {
+	field *BlobField
+	blob  []byte
+}
+
+// Encodes the blob as-is for cases where the Synchro did not handle it.  Implements the
+// cbor.Marshaler interface.
+func (b *blobEgest) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(b.blob)
+}
@@ -161,3 +161,102 @@ func Test_BlobSaveToFile_FileCreateError(t *testing.T) {
 		t.Fatal("expected error when saving to invalid file path, got nil")
 	}
 }
+
+func Test_BlobEgestLargeValue(t *testing.T) {
+
+	f := BlobField{}
+	f.Set(make([]byte, minTransferBlobSize))
+
+	v, ok := f.EgestValue().(*blobEgest)
+	if !ok {
+		t.Fatal("expecting a large blob to be egested as *blobEgest")
+	}
+	if len(v.blob) != minTransferBlobSize {
+		t.Fatal("egested blob has the wrong size")
+	}
+}
+
+func Test_BlobIngestChunks(t *testing.T) {
+
+	f := BlobField{}
+
+	err := f.IngestValue(map[any]any{"Offset": uint64(0), "Size": uint64(5), "Bytes": []byte{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error ingesting first chunk: %v", err)
+	}
+
+	if len(f.Get()) != 0 {
+		t.Fatal("blob was assigned before all chunks were ingested")
+	}
+	if f.Progress() != 0.6 {
+		t.Fatalf("progress is %v after first chunk.  Expecting 0.6", f.Progress())
+	}
+
+	err = f.IngestValue(map[any]any{"Offset": uint64(3), "Size": uint64(5), "Bytes": []byte{4, 5}})
+	if err != nil {
+		t.Fatalf("unexpected error ingesting second chunk: %v", err)
+	}
+
+	if !reflect.DeepEqual(f.Get(), []byte{1, 2, 3, 4, 5}) {
+		t.Fatal("blob was not assembled correctly from chunks")
+	}
+	if f.Progress() != 1 {
+		t.Fatalf("progress is %v after last chunk.  Expecting 1", f.Progress())
+	}
+}
+
+func Test_BlobIngestChunkOutOfSequence(t *testing.T) {
+
+	f := BlobField{}
+
+	err := f.IngestValue(map[any]any{"Offset": uint64(0), "Size": uint64(5), "Bytes": []byte{1, 2}})
+	if err != nil {
+		t.Fatalf("unexpected error ingesting first chunk: %v", err)
+	}
+
+	err = f.IngestValue(map[any]any{"Offset": uint64(3), "Size": uint64(5), "Bytes": []byte{4, 5}})
+	if err == nil || err.Error() != "blob chunk is out of sequence" {
+		t.Fatal("expecting an error for chunk that is out of sequence")
+	}
+}
+
+func Test_BlobIngestChunkTooLarge(t *testing.T) {
+
+	f := BlobField{}
+
+	err := f.IngestValue(map[any]any{"Offset": uint64(0), "Size": uint64(2), "Bytes": []byte{1, 2, 3}})
+	if err == nil || err.Error() != "blob chunk exceeds the size of the blob" {
+		t.Fatal("expecting an error for chunk that exceeds the size of the blob")
+	}
+}
+
+func Test_BlobHash(t *testing.T) {
+	f := BlobField{}
+	f.Set([]byte("abc"))
+
+	if f.Hash() != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Fatalf("incorrect hash returned: %s", f.Hash())
+	}
+
+	f.Set([]byte("abcd"))
+	if f.Hash() != "88d4266fd4e6338d13b845fcf289579d209c897823b9217da3e161936f031589" {
+		t.Fatal("hash was not updated after setting a new blob")
+	}
+}
+
+func Test_BlobSetSameContents(t *testing.T) {
+	f := BlobField{}
+	f.Set([]byte{1, 2, 3})
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set([]byte{1, 2, 3})
+	if testOnsetCalled {
+		t.Error("onset was called even though the contents didn't change")
+	}
+
+	// Same slice might have been modified in place so it's always treated as changed
+	f.Set(f.Get())
+	if !testOnsetCalled {
+		t.Error("onset was not called when setting the same slice")
+	}
+}
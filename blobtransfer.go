@@ -0,0 +1,224 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/prontogui/golib/key"
+)
+
+// The default maximum number of bytes of a blob sent to the App in a single update.  Larger
+// blobs are sent in chunks of this size over successive updates.
+const DefaultBlobChunkSize = 1024 * 1024
+
+// The maximum number of blobs remembered as kept in the App's cache.
+const maxClientBlobs = 1000
+
+// Returned by Synchro.IngestUpdate for an update that only asks for blobs missing from the App's
+// cache.  The blobs are sent again with the next partial update.
+var errBlobsRequested = errors.New("the App asked for blobs missing from its cache")
+
+// The hashes of the blobs kept in the App's cache, up to maxClientBlobs of them.  The oldest
+// are forgotten first, so they are sent again if needed.
+type blobHashes struct {
+	hashes map[string]bool
+	order  []string
+}
+
+func newBlobHashes() *blobHashes {
+	return &blobHashes{hashes: map[string]bool{}}
+}
+
+// Returns true if the blob with the given hash is kept in the App's cache.
+func (h *blobHashes) has(hash string) bool {
+	return h.hashes[hash]
+}
+
+// Remembers that the blob with the given hash is kept in the App's cache.
+func (h *blobHashes) add(hash string) {
+	if h.hashes[hash] {
+		return
+	}
+	if len(h.order) >= maxClientBlobs {
+		delete(h.hashes, h.order[0])
+		h.order = h.order[1:]
+	}
+	h.hashes[hash] = true
+	h.order = append(h.order, hash)
+}
+
+// Forgets the blob with the given hash.
+func (h *blobHashes) remove(hash string) {
+	if !h.hashes[hash] {
+		return
+	}
+	delete(h.hashes, hash)
+	h.order = slices.DeleteFunc(h.order, func(s string) bool { return s == hash })
+}
+
+// A blob being sent to the App in chunks over successive updates.
+type blobTransfer struct {
+	field  *BlobField
+	pkey   key.PKey
+	fkey   key.FKey
+	blob   []byte
+	hash   string
+	offset int
+}
+
+// Returns true if the transfer still applies to its field.  It doesn't apply anymore if the
+// field was assigned another blob or the field's primitive moved elsewhere in the GUI.
+func (t *blobTransfer) valid() bool {
+	f := t.field
+	return f.pkey.EqualTo(t.pkey) && len(f.blob) == len(t.blob) && &f.blob[0] == &t.blob[0]
+}
+
+// Sets the maximum number of bytes of a blob sent to the App in a single update.  Sizes
+// below 16 KB are raised to 16 KB.  A size of zero or less turns off chunking altogether,
+// for Apps that don't support it, and blobs are always sent whole.  Chunking is off by
+// default, and a session turns it on when the App claims the blob-chunks capability.
+func (s *Synchro) SetBlobChunkSize(size int) {
+	if size <= 0 {
+		size = math.MaxInt
+	} else if size < minTransferBlobSize {
+		size = minTransferBlobSize
+	}
+	s.blobChunkSize = size
+}
+
+// Sets whether the App keeps the blobs it receives in its cache, in which case blobs sent
+// before are referred to by hash rather than sent again.  This is off by default, and a session
+// turns it on when the App claims the blob-cache capability.
+func (s *Synchro) SetBlobCaching(enabled bool) {
+	s.blobCaching = enabled
+}
+
+// Returns true if there are blobs still being sent to the App in chunks, or blobs the App asked
+// to be sent again.
+func (s *Synchro) HasBlobTransfers() bool {
+	return len(s.blobTransfers) > 0 || s.blobsRequested
+}
+
+// Replaces a large blob with either the blob itself, a reference to the blob in the App's
+// cache, or the start of a chunked transfer.
+func (s *Synchro) resolveBlob(b *blobEgest) any {
+
+	hash := b.field.Hash()
+
+	// Refer to the blob in the App's cache if it was sent before, or sent whole earlier in
+	// this update
+	if s.blobCaching && (s.clientBlobs.has(hash) || s.sendingBlobs[hash]) {
+		return map[any]any{blobChunkHash: hash}
+	}
+
+	if len(b.blob) <= s.blobChunkSize {
+		if s.blobCaching {
+			s.sendingBlobs[hash] = true
+		}
+		return b.blob
+	}
+
+	// Any transfer already underway for this field is superseded
+	s.cancelBlobTransfer(b.field)
+
+	s.blobTransfers = append(s.blobTransfers, &blobTransfer{
+		field: b.field,
+		pkey:  key.NewPKey(b.field.pkey...),
+		fkey:  b.field.fkey,
+		blob:  b.blob,
+		hash:  hash,
+	})
+
+	b.field.transferred, b.field.transferSize = 0, len(b.blob)
+
+	// The field's value is just the size and hash for now.  The bytes will follow in chunks.
+	return map[any]any{blobChunkSize: len(b.blob), blobChunkHash: hash}
+}
+
+// Handles any blobs that the App has reported missing from its cache.  These are hash-only
+// maps among the field values of an ingested update for the primitive at pkey.  Each blob is
+// forgotten and removed from the update, and its field is queued to be sent again.  Returns
+// true if there were any.
+func (s *Synchro) resendMissingBlobs(pkey key.PKey, update map[any]any) bool {
+	requested := false
+	for k, v := range update {
+		m, ok := v.(map[any]any)
+		if !ok || len(m) != 1 {
+			continue
+		}
+		hash, ok := m[blobChunkHash].(string)
+		if !ok {
+			continue
+		}
+		s.clientBlobs.remove(hash)
+		delete(update, k)
+		if name, ok := k.(string); ok && key.FKeyFor(name) != key.INVALID_FIELDNAME {
+			s.OnSet(pkey, key.FKeyFor(name), false)
+		}
+		requested = true
+	}
+	s.blobsRequested = s.blobsRequested || requested
+	return requested
+}
+
+// Records the blobs in the update just sent as kept in the App's cache.  This is called once the
+// update is sent, so the blobs of an update that is never sent aren't referred to by hash.
+func (s *Synchro) confirmBlobsSent() {
+	for hash := range s.sendingBlobs {
+		s.clientBlobs.add(hash)
+	}
+	s.sendingBlobs = nil
+}
+
+func (s *Synchro) cancelBlobTransfer(field *BlobField) {
+	transfers := s.blobTransfers[:0]
+	for _, t := range s.blobTransfers {
+		if t.field != field {
+			transfers = append(transfers, t)
+		}
+	}
+	s.blobTransfers = transfers
+}
+
+// Returns the next chunk to send to the App as a pkey and update map.  Returns ok = false
+// if there are no more chunks to send.
+func (s *Synchro) nextBlobChunk() (pkey key.PKey, update map[any]any, ok bool) {
+
+	for len(s.blobTransfers) > 0 {
+		t := s.blobTransfers[0]
+
+		if !t.valid() {
+			s.blobTransfers = s.blobTransfers[1:]
+			continue
+		}
+
+		end := min(t.offset+s.blobChunkSize, len(t.blob))
+
+		chunk := map[any]any{
+			blobChunkOffset: t.offset,
+			blobChunkSize:   len(t.blob),
+			blobChunkBytes:  t.blob[t.offset:end],
+		}
+
+		t.offset = end
+		t.field.transferred = end
+
+		if t.offset == len(t.blob) {
+			s.blobTransfers = s.blobTransfers[1:]
+			if s.blobCaching {
+				s.sendingBlobs[t.hash] = false
+			}
+		}
+
+		return t.pkey, map[any]any{s.encodeFieldKey(t.fkey): chunk}, true
+	}
+
+	return nil, nil, false
+}
@@ -22,6 +22,9 @@ func (f *BooleanField) Get() bool {
 }
 
 func (f *BooleanField) Set(b bool) {
+	if b == f.b {
+		return
+	}
 	f.b = b
 	f.OnSet(false)
 }
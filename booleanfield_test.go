@@ -94,3 +94,15 @@ func Test_BooleanIngestUpdateInvalid(t *testing.T) {
 	err := f.IngestValue(10)
 	verifyIngestUpdateInvalid(t, err)
 }
+
+func Test_BooleanSetSameValue(t *testing.T) {
+	f := BooleanField{}
+	f.Set(true)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set(true)
+
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/prontogui/golib/key"
+)
+
+// The guts shared by LineChart, BarChart, and ScatterChart, which only differ in how their
+// series are shown.  The App tells them apart by the name of the field holding the series,
+// such as LineSeries.
+type chartBase struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment StringField
+	legend     BooleanField
+	series     Any1DField
+	status     IntegerField
+	tag        StringField
+	title      StringField
+	xAxis      AnyField
+	yAxis      AnyField
+
+	// The maximum number of points kept in each series, or 0 for no maximum.
+	windowSize int
+}
+
+// Prepares the chart for tracking pending updates, with its series in the field given by
+// seriesFKey.
+func (chart *chartBase) prepareForUpdates(seriesFKey key.FKey, pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	chart.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		fields := []FieldRef{
+			{key.FKey_Embodiment, &chart.embodiment},
+			{key.FKey_Legend, &chart.legend},
+			{seriesFKey, &chart.series},
+			{key.FKey_Status, &chart.status},
+			{key.FKey_Tag, &chart.tag},
+			{key.FKey_Title, &chart.title},
+			{key.FKey_XAxis, &chart.xAxis},
+			{key.FKey_YAxis, &chart.yAxis},
+		}
+
+		// Fields are attached in the order of their names
+		slices.SortFunc(fields, func(a, b FieldRef) int {
+			return strings.Compare(key.FieldnameFor(a.fkey), key.FieldnameFor(b.fkey))
+		})
+		return fields
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (chart *chartBase) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	nextIndex := locator.NextIndex()
+
+	switch nextIndex {
+	case 0:
+		return itemAt(chart.series.Get(), locator.NextIndex())
+	case 1:
+		return chart.xAxis.Get()
+	case 2:
+		return chart.yAxis.Get()
+	}
+
+	return nil
+}
+
+// Returns a string representation of this primitive:  the title.
+// Implements of fmt:Stringer interface.
+func (chart *chartBase) String() string {
+	return chart.title.Get()
+}
+
+// Adds a point to the end of a series, given by its index.  Only the new point is sent to the
+// App.  The oldest point of the series is dropped if it then has more points than the window
+// size.  Returns an error if there is no such series.
+func (chart *chartBase) AddPoint(series int, x, y float64) error {
+	all := chart.chartSeries()
+	if series < 0 || series >= len(all) {
+		return fmt.Errorf("there is no series %d", series)
+	}
+	all[series].AddPoint(x, y)
+	return nil
+}
+
+// Returns the series shown in the chart.
+func (chart *chartBase) chartSeries() []*ChartSeries {
+	series := make([]*ChartSeries, 0, len(chart.series.Get()))
+	for _, item := range chart.series.Get() {
+		if s, ok := item.(*ChartSeries); ok {
+			series = append(series, s)
+		}
+	}
+	return series
+}
+
+// Sets the series shown in the chart, applying the window size of the chart to each one.
+func (chart *chartBase) setChartSeries(series []*ChartSeries) {
+	items := make([]Primitive, len(series))
+	for i, s := range series {
+		if chart.windowSize > 0 {
+			s.SetWindowSize(chart.windowSize)
+		}
+		items[i] = s
+	}
+	chart.series.Set(items)
+}
+
+// Returns the maximum number of points kept in each series, or 0 for no maximum.
+func (chart *chartBase) WindowSize() int {
+	return chart.windowSize
+}
+
+// Sets the maximum number of points kept in each series, or 0 for no maximum.  A negative size
+// is taken as 0.
+func (chart *chartBase) setWindowSize(n int) {
+	chart.windowSize = max(n, 0)
+	for _, series := range chart.chartSeries() {
+		series.SetWindowSize(chart.windowSize)
+	}
+}
+
+// Returns the title shown above the chart.
+func (chart *chartBase) Title() string {
+	return chart.title.Get()
+}
+
+// Returns true if a legend with the label of each series is shown.
+func (chart *chartBase) Legend() bool {
+	return chart.legend.Get()
+}
+
+// Returns the X axis, or nil if the App decides how to show it.
+func (chart *chartBase) XAxis() *Axis {
+	axis, _ := chart.xAxis.Get().(*Axis)
+	return axis
+}
+
+// Returns the Y axis, or nil if the App decides how to show it.
+func (chart *chartBase) YAxis() *Axis {
+	axis, _ := chart.yAxis.Get().(*Axis)
+	return axis
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (chart *chartBase) Embodiment() string {
+	return chart.embodiment.Get()
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (chart *chartBase) Tag() string {
+	return chart.tag.Get()
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *chartBase) Status() int {
+	return p.status.Get()
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *chartBase) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *chartBase) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *chartBase) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Returns an axis as a primitive, which is nil for a nil axis.
+func axisPrimitive(axis *Axis) Primitive {
+	if axis == nil {
+		return nil
+	}
+	return axis
+}
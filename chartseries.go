@@ -0,0 +1,176 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"slices"
+
+	"github.com/prontogui/golib/key"
+)
+
+// A series of points shown in a LineChart, BarChart, or ScatterChart.
+type ChartSeriesWith struct {
+	Embodiment string
+	Label      string
+	Points     []Point
+	Tag        string
+	WindowSize int
+}
+
+// Makes a new ChartSeries with specified field values.
+func (w ChartSeriesWith) Make() *ChartSeries {
+	series := &ChartSeries{}
+	series.embodiment.Set(w.Embodiment)
+	series.label.Set(w.Label)
+	series.points.Set(w.Points)
+	series.tag.Set(w.Tag)
+	series.SetWindowSize(w.WindowSize)
+	return series
+}
+
+// A series of points shown in a LineChart, BarChart, or ScatterChart.  The label of the series
+// is shown in the legend of the chart.
+//
+// Points can be streamed to the App using AddPoint, which only sends the new points to Apps
+// that support it.  Set a window size to keep only the most recent points.
+type ChartSeries struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment StringField
+	label      StringField
+	points     PointsField
+	tag        StringField
+
+	// The maximum number of points kept, or 0 for no maximum.
+	windowSize int
+}
+
+// Creates a new series with a label and its points.
+func NewChartSeries(label string, points ...Point) *ChartSeries {
+	return ChartSeriesWith{Label: label, Points: points}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (series *ChartSeries) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	series.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &series.embodiment},
+			{key.FKey_Label, &series.label},
+			{key.FKey_Points, &series.points},
+			{key.FKey_Tag, &series.tag},
+		}
+	})
+}
+
+// Egests an update to send to the app.  A partial update only includes the points added since
+// the points were last sent.  This is used internally by this library and normally should not
+// be called by users of the library.
+func (series *ChartSeries) EgestUpdate(fullupdate bool, fkeys []key.FKey) map[any]any {
+	if fullupdate || !slices.Contains(fkeys, key.FKey_Points) {
+		return series.PrimitiveBase.EgestUpdate(fullupdate, fkeys)
+	}
+
+	others := slices.DeleteFunc(slices.Clone(fkeys), func(fkey key.FKey) bool {
+		return fkey == key.FKey_Points
+	})
+	update := series.PrimitiveBase.EgestUpdate(false, others)
+	update[key.FieldnameFor(key.FKey_Points)] = &pointsEgest{field: &series.points}
+	return update
+}
+
+// Returns a string representation of this primitive:  the label.
+// Implements of fmt:Stringer interface.
+func (series *ChartSeries) String() string {
+	return series.label.Get()
+}
+
+// Adds a point to the end of the series.  The oldest point is dropped if the series then has
+// more points than the window size.
+func (series *ChartSeries) AddPoint(x, y float64) *ChartSeries {
+	series.points.Append(series.windowSize, Point{X: x, Y: y})
+	return series
+}
+
+// Adds points to the end of the series.  The oldest points are dropped if the series then has
+// more points than the window size.
+func (series *ChartSeries) AddPoints(points ...Point) *ChartSeries {
+	series.points.Append(series.windowSize, points...)
+	return series
+}
+
+// Returns the points of the series.
+func (series *ChartSeries) Points() []Point {
+	return series.points.Get()
+}
+
+// Sets the points of the series, which are all sent to the App.  Use AddPoint or AddPoints
+// to send only new points.
+func (series *ChartSeries) SetPoints(points []Point) *ChartSeries {
+	series.points.Set(points)
+	series.trimToWindow()
+	return series
+}
+
+// Returns the maximum number of points kept, or 0 for no maximum.
+func (series *ChartSeries) WindowSize() int {
+	return series.windowSize
+}
+
+// Sets the maximum number of points kept, or 0 for no maximum.  The oldest points are dropped
+// once there are more.
+func (series *ChartSeries) SetWindowSize(n int) *ChartSeries {
+	series.windowSize = max(n, 0)
+	series.trimToWindow()
+	return series
+}
+
+// Drops the oldest points if there are more than the window size.
+func (series *ChartSeries) trimToWindow() {
+	points := series.points.Get()
+	if series.windowSize > 0 && len(points) > series.windowSize {
+		series.points.Set(points[len(points)-series.windowSize:])
+	}
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (series *ChartSeries) Embodiment() string {
+	return series.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (series *ChartSeries) SetEmbodiment(s string) *ChartSeries {
+	series.embodiment.Set(s)
+	return series
+}
+
+// Returns the label of the series shown in the legend.
+func (series *ChartSeries) Label() string {
+	return series.label.Get()
+}
+
+// Sets the label of the series shown in the legend.
+func (series *ChartSeries) SetLabel(s string) *ChartSeries {
+	series.label.Set(s)
+	return series
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (series *ChartSeries) Tag() string {
+	return series.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (series *ChartSeries) SetTag(s string) *ChartSeries {
+	series.tag.Set(s)
+	return series
+}
@@ -0,0 +1,61 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/prontogui/golib/key"
+)
+
+func Test_ChartSeriesAttachedFields(t *testing.T) {
+	series := &ChartSeries{}
+	series.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, series.PrimitiveBase, "Embodiment", "Label", "Points", "Tag")
+}
+
+func Test_ChartSeriesMake(t *testing.T) {
+	series := ChartSeriesWith{Label: "Temperature", Points: []Point{{0, 20}, {1, 21}, {2, 22}}, WindowSize: 2}.Make()
+
+	if series.Label() != "Temperature" || series.String() != "Temperature" {
+		t.Error("could not initialize Label field")
+	}
+	if !slices.Equal(series.Points(), []Point{{1, 21}, {2, 22}}) {
+		t.Errorf("points are %v.  Expecting them to fit the window", series.Points())
+	}
+}
+
+func Test_ChartSeriesEgestUpdate(t *testing.T) {
+	series := NewChartSeries("Temperature", Point{0, 20})
+	series.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	full := series.EgestUpdate(true, nil)
+	if !reflect.DeepEqual(full["Points"], [][]float64{{0, 20}}) {
+		t.Errorf("full update has points %v", full["Points"])
+	}
+
+	series.AddPoint(1, 21).SetLabel("Temp")
+	partial := series.EgestUpdate(false, []key.FKey{key.FKey_Label, key.FKey_Points})
+	if partial["Label"] != "Temp" {
+		t.Errorf("partial update has label %v", partial["Label"])
+	}
+	points, ok := partial["Points"].(*pointsEgest)
+	if !ok {
+		t.Fatalf("partial update has points %v.  Expecting them to be resolved by the Synchro", partial["Points"])
+	}
+
+	// Without a Synchro, all the points are sent
+	var decoded any
+	b, _ := cbor.Marshal(points)
+	cbor.Unmarshal(b, &decoded)
+	if !reflect.DeepEqual(decoded, []any{[]any{0.0, 20.0}, []any{1.0, 21.0}}) {
+		t.Errorf("points are encoded as %v.  Expecting all the points", decoded)
+	}
+}
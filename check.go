@@ -82,7 +82,7 @@ func (check *Check) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 		return check.LabelItem()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a string representation of this primitive:  the label.
@@ -16,8 +16,10 @@ type ChoiceWith struct {
 	Choices      []string
 	ChoiceLabels []string
 	Embodiment   string
+	ErrorMessage string
 	Status       int
 	Tag          string
+	Validator    Validator
 }
 
 // Makes a new Choice with specified field values.
@@ -27,8 +29,10 @@ func (w ChoiceWith) Make() *Choice {
 	choice.choices.Set(w.Choices)
 	choice.choiceLabels.Set(w.ChoiceLabels)
 	choice.embodiment.Set(w.Embodiment)
+	choice.errorMessage.Set(w.ErrorMessage)
 	choice.status.Set(w.Status)
 	choice.tag.Set(w.Tag)
+	choice.validator = w.Validator
 	return choice
 }
 
@@ -41,8 +45,12 @@ type Choice struct {
 	choices      String1DField
 	choiceLabels String1DField
 	embodiment   StringField
+	errorMessage StringField
 	status       IntegerField
 	tag          StringField
+
+	// Checks the choice made by the user.
+	validator Validator
 }
 
 // Creates a new Choice and assigns the initial Choice and Choices fields.
@@ -60,12 +68,23 @@ func (choice *Choice) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction,
 			{key.FKey_Choice, &choice.choice},
 			{key.FKey_Choices, &choice.choices},
 			{key.FKey_Embodiment, &choice.embodiment},
+			{key.FKey_ErrorMessage, &choice.errorMessage},
 			{key.FKey_Status, &choice.status},
 			{key.FKey_Tag, &choice.tag},
 		}
 	})
 }
 
+// Ingests an update from the app and validates the choice made by the user.  This is used
+// internally by this library and normally should not be called by users of the library.
+func (choice *Choice) IngestUpdate(update map[any]any) error {
+	if err := choice.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	choice.Validate()
+	return nil
+}
+
 // Returns a string representation of this primitive:  the current choice.
 // Implements of fmt:Stringer interface.
 func (choice *Choice) String() string {
@@ -117,6 +136,36 @@ func (choice *Choice) SetChoiceLabelsVA(sa ...string) *Choice {
 	return choice
 }
 
+// Returns the validator that checks the choice made by the user, or nil if there is none.
+func (choice *Choice) Validator() Validator {
+	return choice.validator
+}
+
+// Sets the validator that checks the choice made by the user, such as Required.  The choice
+// is validated each time the user changes it, and any error is shown in the ErrorMessage field.
+func (choice *Choice) SetValidator(v Validator) *Choice {
+	choice.validator = v
+	return choice
+}
+
+// Validates the choice and shows any error in the ErrorMessage field.  Returns the error from
+// the validator, or nil if the choice is valid or there is no validator.
+func (choice *Choice) Validate() error {
+	return validateEntry(choice.validator, choice.choice.Get(), &choice.errorMessage)
+}
+
+// Returns the error message shown to the user, or empty if there is none.
+func (choice *Choice) ErrorMessage() string {
+	return choice.errorMessage.Get()
+}
+
+// Sets the error message shown to the user.  This is normally set by Validate but can be
+// set directly, such as for errors found by other means.
+func (choice *Choice) SetErrorMessage(s string) *Choice {
+	choice.errorMessage.Set(s)
+	return choice
+}
+
 // Returns a JSON string specifying the embodiment to use for this primitive.
 func (choice *Choice) Embodiment() string {
 	return choice.embodiment.Get()
@@ -16,7 +16,7 @@ import (
 func Test_ChoiceAttachedFields(t *testing.T) {
 	cmd := &Choice{}
 	cmd.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
-	verifyAllFieldsAttached(t, cmd.PrimitiveBase, "Choice", "Choices", "Embodiment", "Tag")
+	verifyAllFieldsAttached(t, cmd.PrimitiveBase, "Choice", "Choices", "Embodiment", "ErrorMessage", "Tag")
 }
 
 func Test_ChoiceMake(t *testing.T) {
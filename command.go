@@ -17,6 +17,7 @@ type CommandWith struct {
 	Embodiment string
 	Label      string
 	LabelItem  Primitive
+	Shortcut   string
 	Status     int
 	Tag        string
 }
@@ -29,6 +30,7 @@ func (w CommandWith) Make() *Command {
 	cmd.embodiment.Set(w.Embodiment)
 	cmd.label.Set(w.Label)
 	cmd.labelItem.Set(w.LabelItem)
+	cmd.SetShortcut(w.Shortcut)
 	cmd.status.Set(w.Status)
 	cmd.tag.Set(w.Tag)
 
@@ -46,6 +48,7 @@ type Command struct {
 	embodiment    StringField
 	label         StringField
 	labelItem     AnyField
+	shortcut      StringField
 	status        IntegerField
 	tag           StringField
 }
@@ -67,6 +70,7 @@ func (cmd *Command) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, et
 			{key.FKey_Embodiment, &cmd.embodiment},
 			{key.FKey_Label, &cmd.label},
 			{key.FKey_LabelItem, &cmd.labelItem},
+			{key.FKey_Shortcut, &cmd.shortcut},
 			{key.FKey_Status, &cmd.status},
 			{key.FKey_Tag, &cmd.tag},
 		}
@@ -86,7 +90,7 @@ func (cmd *Command) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 		return cmd.LabelItem()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a string representation of this primitive:  the label.
@@ -133,6 +137,19 @@ func (cmd *Command) SetLabelItem(item Primitive) *Command {
 	return cmd
 }
 
+// Returns the keyboard shortcut that issues the command, such as "F5", or empty if none.
+func (cmd *Command) Shortcut() string {
+	return cmd.shortcut.Get()
+}
+
+// Sets the keyboard shortcut that issues the command, such as "F5".  The App issues the
+// command when the shortcut is pressed while the command is enabled.  See NormalizeKey for
+// how keys are named.
+func (cmd *Command) SetShortcut(s string) *Command {
+	cmd.shortcut.Set(shortcutName(s))
+	return cmd
+}
+
 // Returns an optional and arbitrary string to keep with this primitive.  This is useful for
 // identification later on, such as using Commands as Table cells.
 func (cmd *Command) Tag() string {
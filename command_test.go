@@ -15,11 +15,11 @@ import (
 func Test_CommandAttachedFields(t *testing.T) {
 	cmd := &Command{}
 	cmd.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
-	verifyAllFieldsAttached(t, cmd.PrimitiveBase, "Embodiment", "Label", "Status", "Tag")
+	verifyAllFieldsAttached(t, cmd.PrimitiveBase, "Embodiment", "Label", "Shortcut", "Status", "Tag")
 }
 
 func Test_CommandMake(t *testing.T) {
-	cmd := CommandWith{Embodiment: "raised-btn", Label: "Press Me", Status: 1, Tag: "F"}.Make()
+	cmd := CommandWith{Embodiment: "raised-btn", Label: "Press Me", Shortcut: "f5", Status: 1, Tag: "F"}.Make()
 
 	if cmd.Embodiment() != "raised-btn" {
 		t.Error("Could not initialize Embodiment field.")
@@ -29,6 +29,10 @@ func Test_CommandMake(t *testing.T) {
 		t.Error("Could not initialize Label field.")
 	}
 
+	if cmd.Shortcut() != "F5" {
+		t.Error("Could not initialize Shortcut field.")
+	}
+
 	if cmd.Status() != 1 {
 		t.Error("Could not initialize Status field.")
 	}
@@ -52,6 +56,11 @@ func Test_CommandFieldSetting(t *testing.T) {
 		t.Error("Could not set Label field.")
 	}
 
+	cmd.SetShortcut("ctrl+r")
+	if cmd.Shortcut() != "Ctrl+R" {
+		t.Error("Could not set Shortcut field.")
+	}
+
 	cmd.SetStatus(2)
 	if cmd.Status() != 2 {
 		t.Error("Could not set Status field.")
@@ -0,0 +1,258 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// A field for choosing a date.  It is often represented using a calendar.
+type DatePickerWith struct {
+	Earliest   time.Time
+	Embodiment string
+	Latest     time.Time
+	Location   *time.Location
+	Status     int
+	Tag        string
+	Date       time.Time
+}
+
+// Creates a new DatePicker using the supplied field assignments.
+func (w DatePickerWith) Make() *DatePicker {
+	dp := &DatePicker{}
+	dp.SetLocation(w.Location)
+	dp.SetEarliest(w.Earliest)
+	dp.embodiment.Set(w.Embodiment)
+	dp.SetLatest(w.Latest)
+	dp.status.Set(w.Status)
+	dp.tag.Set(w.Tag)
+	dp.SetDate(w.Date)
+	return dp
+}
+
+// A field for choosing a date.  It is often represented using a calendar.
+type DatePicker struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	earliest   TimeField
+	embodiment StringField
+	latest     TimeField
+	status     IntegerField
+	tag        StringField
+	date       TimeField
+	timeZone   StringField
+
+	// The location of the times held by this primitive.
+	location *time.Location
+}
+
+// Creates a new DatePicker with an initial date.
+func NewDatePicker(t time.Time) *DatePicker {
+	return DatePickerWith{Date: t}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (dp *DatePicker) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	dp.setFieldFormats()
+
+	dp.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Date, &dp.date},
+			{key.FKey_Earliest, &dp.earliest},
+			{key.FKey_Embodiment, &dp.embodiment},
+			{key.FKey_Latest, &dp.latest},
+			{key.FKey_Status, &dp.status},
+			{key.FKey_Tag, &dp.tag},
+			{key.FKey_TimeZone, &dp.timeZone},
+		}
+	})
+}
+
+// Ingests an update from the app.  A date outside of the Earliest and Latest bounds is
+// not kept, and the previous date is sent back to the app instead.  This is used internally
+// by this library and normally should not be called by users of the library.
+func (dp *DatePicker) IngestUpdate(update map[any]any) error {
+	previous := dp.date.Get()
+	if err := dp.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	if !inTimeBounds(dp.date.Get(), dp.earliest.Get(), dp.latest.Get()) {
+		dp.date.Set(previous)
+	}
+	return nil
+}
+
+// Sets the layout and location of the time fields.
+func (dp *DatePicker) setFieldFormats() {
+	loc := dp.Location()
+	dp.earliest.setFormat(dateLayout, loc)
+	dp.latest.setFormat(dateLayout, loc)
+	dp.date.setFormat(dateLayout, loc)
+}
+
+// Returns a string representation of this primitive:  the date, or empty if none is chosen.
+// Implements of fmt:Stringer interface.
+func (dp *DatePicker) String() string {
+	return formatTime(dp.date.Get(), dateLayout)
+}
+
+// Returns the date chosen by the user, or a zero time if none is chosen.  The date is at midnight in the
+// primitive's Location.
+func (dp *DatePicker) Date() time.Time {
+	return dp.date.Get()
+}
+
+// Sets the date chosen by the user, or a zero time if none is chosen.  Only the date of t in the primitive's
+// Location is kept.
+func (dp *DatePicker) SetDate(t time.Time) *DatePicker {
+	dp.date.Set(normalizeDate(t, dp.Location()))
+	return dp
+}
+
+// Returns the earliest date that can be chosen, or a zero time if there is no limit.
+func (dp *DatePicker) Earliest() time.Time {
+	return dp.earliest.Get()
+}
+
+// Sets the earliest date that can be chosen, or a zero time if there is no limit.
+func (dp *DatePicker) SetEarliest(t time.Time) *DatePicker {
+	dp.earliest.Set(normalizeDate(t, dp.Location()))
+	return dp
+}
+
+// Returns the latest date that can be chosen, or a zero time if there is no limit.
+func (dp *DatePicker) Latest() time.Time {
+	return dp.latest.Get()
+}
+
+// Sets the latest date that can be chosen, or a zero time if there is no limit.
+func (dp *DatePicker) SetLatest(t time.Time) *DatePicker {
+	dp.latest.Set(normalizeDate(t, dp.Location()))
+	return dp
+}
+
+// Returns the location of the times held by this primitive.  The default is time.Local.
+func (dp *DatePicker) Location() *time.Location {
+	if dp.location == nil {
+		return time.Local
+	}
+	return dp.location
+}
+
+// Sets the location of the times held by this primitive, or nil for time.Local.  The
+// App is told the name of its time zone using the TimeZone field.
+func (dp *DatePicker) SetLocation(loc *time.Location) *DatePicker {
+	dp.location = loc
+	if loc == nil {
+		dp.timeZone.Set("")
+	} else {
+		dp.timeZone.Set(loc.String())
+	}
+	dp.setFieldFormats()
+	dp.SetEarliest(dp.earliest.Get())
+	dp.SetLatest(dp.latest.Get())
+	dp.SetDate(dp.date.Get())
+	return dp
+}
+
+// Returns the name of the time zone used by the App, such as "America/New_York", or empty if
+// the App uses its own time zone.  This is set by SetLocation.
+func (dp *DatePicker) TimeZone() string {
+	return dp.timeZone.Get()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (dp *DatePicker) Embodiment() string {
+	return dp.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (dp *DatePicker) SetEmbodiment(s string) *DatePicker {
+	dp.embodiment.Set(s)
+	return dp
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using DatePickers as Table cells.
+func (dp *DatePicker) Tag() string {
+	return dp.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using DatePickers as Table cells.
+func (dp *DatePicker) SetTag(s string) *DatePicker {
+	dp.tag.Set(s)
+	return dp
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *DatePicker) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *DatePicker) SetStatus(i int) *DatePicker {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *DatePicker) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *DatePicker) SetVisible(visible bool) *DatePicker {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *DatePicker) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *DatePicker) SetEnabled(enabled bool) *DatePicker {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *DatePicker) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *DatePicker) SetCollapsed(collapsed bool) *DatePicker {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
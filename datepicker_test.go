@@ -0,0 +1,93 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_DatePickerAttach(t *testing.T) {
+	dp := &DatePicker{}
+	dp.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, dp.PrimitiveBase, "Date", "Earliest", "Embodiment", "Latest", "Status", "Tag", "TimeZone")
+}
+
+func Test_DatePickerMake(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	dp := DatePickerWith{
+		Date:     time.Date(2026, 5, 1, 20, 30, 0, 0, time.UTC),
+		Earliest: time.Date(2026, 1, 1, 0, 0, 0, 0, tokyo),
+		Location: tokyo,
+		Tag:      "due",
+	}.Make()
+
+	// 20:30 UTC on May 1 is May 2 in Tokyo
+	if !dp.Date().Equal(time.Date(2026, 5, 2, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("date is %v.  Expecting midnight of May 2 in Tokyo", dp.Date())
+	}
+	if dp.String() != "2026-05-02" {
+		t.Errorf("string is %q", dp.String())
+	}
+	if dp.TimeZone() != "Asia/Tokyo" {
+		t.Errorf("time zone is %q", dp.TimeZone())
+	}
+	if dp.Earliest().IsZero() || !dp.Latest().IsZero() {
+		t.Error("could not initialize Earliest and Latest fields")
+	}
+	if dp.Tag() != "due" {
+		t.Error("could not initialize Tag field")
+	}
+}
+
+func Test_DatePickerEgest(t *testing.T) {
+	dp := NewDatePicker(time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC)).SetLocation(time.UTC)
+	dp.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	update := dp.EgestUpdate(true, nil)
+	if update["Date"] != "2026-10-19" || update["Earliest"] != "" || update["TimeZone"] != "UTC" {
+		t.Errorf("egested update is %v", update)
+	}
+}
+
+func Test_DatePickerIngest(t *testing.T) {
+	dp := DatePickerWith{
+		Earliest: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Latest:   time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+		Location: time.UTC,
+	}.Make()
+	var sentBack int
+	dp.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack++ }, getBogeyEventTimestampProvider())
+
+	if err := dp.IngestUpdate(map[any]any{"Date": "2026-07-04"}); err != nil {
+		t.Fatal(err)
+	}
+	if !dp.Date().Equal(time.Date(2026, 7, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("date is %v", dp.Date())
+	}
+
+	for _, date := range []string{"2025-12-31", "2027-01-01"} {
+		sentBack = 0
+		if err := dp.IngestUpdate(map[any]any{"Date": date}); err != nil {
+			t.Errorf("unexpected error for date %q: %v", date, err)
+		}
+		if dp.String() != "2026-07-04" {
+			t.Fatalf("date %q out of bounds was stored", date)
+		}
+		if sentBack != 1 {
+			t.Errorf("previous date was not sent back for date %q", date)
+		}
+	}
+
+	if err := dp.IngestUpdate(map[any]any{"Date": "July 4th"}); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("error for a malformed date is %v.  Expecting ErrInvalidValue", err)
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// A field for choosing a date and a time of day.
+type DateTimePickerWith struct {
+	Earliest   time.Time
+	Embodiment string
+	Latest     time.Time
+	Location   *time.Location
+	Status     int
+	Tag        string
+	DateTime   time.Time
+}
+
+// Creates a new DateTimePicker using the supplied field assignments.
+func (w DateTimePickerWith) Make() *DateTimePicker {
+	dtp := &DateTimePicker{}
+	dtp.SetLocation(w.Location)
+	dtp.SetEarliest(w.Earliest)
+	dtp.embodiment.Set(w.Embodiment)
+	dtp.SetLatest(w.Latest)
+	dtp.status.Set(w.Status)
+	dtp.tag.Set(w.Tag)
+	dtp.SetDateTime(w.DateTime)
+	return dtp
+}
+
+// A field for choosing a date and a time of day.
+type DateTimePicker struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	earliest   TimeField
+	embodiment StringField
+	latest     TimeField
+	status     IntegerField
+	tag        StringField
+	dateTime   TimeField
+	timeZone   StringField
+
+	// The location of the times held by this primitive.
+	location *time.Location
+}
+
+// Creates a new DateTimePicker with an initial date and time.
+func NewDateTimePicker(t time.Time) *DateTimePicker {
+	return DateTimePickerWith{DateTime: t}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (dtp *DateTimePicker) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	dtp.setFieldFormats()
+
+	dtp.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_DateTime, &dtp.dateTime},
+			{key.FKey_Earliest, &dtp.earliest},
+			{key.FKey_Embodiment, &dtp.embodiment},
+			{key.FKey_Latest, &dtp.latest},
+			{key.FKey_Status, &dtp.status},
+			{key.FKey_Tag, &dtp.tag},
+			{key.FKey_TimeZone, &dtp.timeZone},
+		}
+	})
+}
+
+// Ingests an update from the app.  A date and time outside of the Earliest and Latest bounds is
+// not kept, and the previous date and time is sent back to the app instead.  This is used internally
+// by this library and normally should not be called by users of the library.
+func (dtp *DateTimePicker) IngestUpdate(update map[any]any) error {
+	previous := dtp.dateTime.Get()
+	if err := dtp.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	if !inTimeBounds(dtp.dateTime.Get(), dtp.earliest.Get(), dtp.latest.Get()) {
+		dtp.dateTime.Set(previous)
+	}
+	return nil
+}
+
+// Sets the layout and location of the time fields.
+func (dtp *DateTimePicker) setFieldFormats() {
+	loc := dtp.Location()
+	dtp.earliest.setFormat(time.RFC3339, loc)
+	dtp.latest.setFormat(time.RFC3339, loc)
+	dtp.dateTime.setFormat(time.RFC3339, loc)
+}
+
+// Returns a string representation of this primitive:  the date and time, or empty if none is
+// chosen.  Implements of fmt:Stringer interface.
+func (dtp *DateTimePicker) String() string {
+	return formatTime(dtp.dateTime.Get(), time.RFC3339)
+}
+
+// Returns the date and time chosen by the user, or a zero time if none is chosen.
+func (dtp *DateTimePicker) DateTime() time.Time {
+	return dtp.dateTime.Get()
+}
+
+// Sets the date and time chosen by the user, or a zero time if none is chosen.  The time is
+// kept to the second.
+func (dtp *DateTimePicker) SetDateTime(t time.Time) *DateTimePicker {
+	dtp.dateTime.Set(normalizeDateTime(t, dtp.Location()))
+	return dtp
+}
+
+// Returns the earliest date and time that can be chosen, or a zero time if there is no limit.
+func (dtp *DateTimePicker) Earliest() time.Time {
+	return dtp.earliest.Get()
+}
+
+// Sets the earliest date and time that can be chosen, or a zero time if there is no limit.
+func (dtp *DateTimePicker) SetEarliest(t time.Time) *DateTimePicker {
+	dtp.earliest.Set(normalizeDateTime(t, dtp.Location()))
+	return dtp
+}
+
+// Returns the latest date and time that can be chosen, or a zero time if there is no limit.
+func (dtp *DateTimePicker) Latest() time.Time {
+	return dtp.latest.Get()
+}
+
+// Sets the latest date and time that can be chosen, or a zero time if there is no limit.
+func (dtp *DateTimePicker) SetLatest(t time.Time) *DateTimePicker {
+	dtp.latest.Set(normalizeDateTime(t, dtp.Location()))
+	return dtp
+}
+
+// Returns the location of the times held by this primitive.  The default is time.Local.
+func (dtp *DateTimePicker) Location() *time.Location {
+	if dtp.location == nil {
+		return time.Local
+	}
+	return dtp.location
+}
+
+// Sets the location of the times held by this primitive, or nil for time.Local.  The
+// App is told the name of its time zone using the TimeZone field.
+func (dtp *DateTimePicker) SetLocation(loc *time.Location) *DateTimePicker {
+	dtp.location = loc
+	if loc == nil {
+		dtp.timeZone.Set("")
+	} else {
+		dtp.timeZone.Set(loc.String())
+	}
+	dtp.setFieldFormats()
+	dtp.SetEarliest(dtp.earliest.Get())
+	dtp.SetLatest(dtp.latest.Get())
+	dtp.SetDateTime(dtp.dateTime.Get())
+	return dtp
+}
+
+// Returns the name of the time zone used by the App, such as "America/New_York", or empty if
+// the App uses its own time zone.  This is set by SetLocation.
+func (dtp *DateTimePicker) TimeZone() string {
+	return dtp.timeZone.Get()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (dtp *DateTimePicker) Embodiment() string {
+	return dtp.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (dtp *DateTimePicker) SetEmbodiment(s string) *DateTimePicker {
+	dtp.embodiment.Set(s)
+	return dtp
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using DateTimePickers as Table cells.
+func (dtp *DateTimePicker) Tag() string {
+	return dtp.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using DateTimePickers as Table cells.
+func (dtp *DateTimePicker) SetTag(s string) *DateTimePicker {
+	dtp.tag.Set(s)
+	return dtp
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *DateTimePicker) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *DateTimePicker) SetStatus(i int) *DateTimePicker {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *DateTimePicker) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *DateTimePicker) SetVisible(visible bool) *DateTimePicker {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *DateTimePicker) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *DateTimePicker) SetEnabled(enabled bool) *DateTimePicker {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *DateTimePicker) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *DateTimePicker) SetCollapsed(collapsed bool) *DateTimePicker {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
+
+// Layouts used to send dates and times to the App.
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04:05"
+)
+
+// Returns midnight of the date of t in loc, or a zero time if t is zero.
+func normalizeDate(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// Returns the time of day of t in loc on January 1 of year 0, or a zero time if t is zero.
+func normalizeTime(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	h, m, s := t.In(loc).Clock()
+	return time.Date(0, time.January, 1, h, m, s, 0, loc)
+}
+
+// Returns t in loc to the second, or a zero time if t is zero.
+func normalizeDateTime(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.Truncate(time.Second).In(loc)
+}
+
+// Returns t formatted using layout, or empty if t is zero.
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
+}
+
+// Returns true if t is within the earliest and latest times, if they are given.  A zero t is
+// always within them.
+func inTimeBounds(t, earliest, latest time.Time) bool {
+	if t.IsZero() {
+		return true
+	}
+	return (earliest.IsZero() || !t.Before(earliest)) && (latest.IsZero() || !t.After(latest))
+}
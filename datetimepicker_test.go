@@ -0,0 +1,61 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_DateTimePickerAttach(t *testing.T) {
+	dtp := &DateTimePicker{}
+	dtp.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, dtp.PrimitiveBase, "DateTime", "Earliest", "Embodiment", "Latest", "Status", "Tag", "TimeZone")
+}
+
+func Test_DateTimePickerEgest(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	dtp := DateTimePickerWith{
+		DateTime: time.Date(2026, 10, 19, 14, 0, 0, 999, time.UTC),
+		Location: est,
+	}.Make()
+	dtp.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	update := dtp.EgestUpdate(true, nil)
+	if update["DateTime"] != "2026-10-19T09:00:00-05:00" || update["TimeZone"] != "EST" {
+		t.Errorf("egested update is %v", update)
+	}
+	if dtp.DateTime().Location() != est {
+		t.Error("date and time is not in the primitive's location")
+	}
+}
+
+func Test_DateTimePickerIngest(t *testing.T) {
+	dtp := DateTimePickerWith{
+		Earliest: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Location: time.UTC,
+	}.Make()
+	var sentBack bool
+	dtp.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack = true }, getBogeyEventTimestampProvider())
+
+	if err := dtp.IngestUpdate(map[any]any{"DateTime": "2026-06-01T12:00:00+02:00"}); err != nil {
+		t.Fatal(err)
+	}
+	if !dtp.DateTime().Equal(time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)) || dtp.DateTime().Location() != time.UTC {
+		t.Errorf("date and time is %v.  Expecting it in UTC", dtp.DateTime())
+	}
+
+	sentBack = false
+	if err := dtp.IngestUpdate(map[any]any{"DateTime": "2025-06-01T12:00:00Z"}); err != nil {
+		t.Fatal(err)
+	}
+	if !dtp.DateTime().Equal(time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)) || !sentBack {
+		t.Error("date and time before the earliest allowed was stored instead of sending back the previous one")
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A dialog shows content on top of the rest of the GUI, along with buttons for the user to
+// respond with.  While showing, the user can only interact with the dialog.
+type DialogWith struct {
+	Buttons     []*Command
+	DialogItems []Primitive
+	Embodiment  string
+	Showing     bool
+	Status      int
+	Tag         string
+	Title       string
+}
+
+// Creates a new Dialog using the supplied field assignments.
+func (w DialogWith) Make() *Dialog {
+	dialog := &Dialog{}
+	dialog.SetButtons(w.Buttons...)
+	dialog.dialogItems.Set(w.DialogItems)
+	dialog.embodiment.Set(w.Embodiment)
+	dialog.showing.Set(w.Showing)
+	dialog.status.Set(w.Status)
+	dialog.tag.Set(w.Tag)
+	dialog.title.Set(w.Title)
+	return dialog
+}
+
+// A dialog shows content on top of the rest of the GUI, along with buttons for the user to
+// respond with.  While showing, the user can only interact with the dialog.
+//
+// A dialog can be part of the GUI, in which case it is shown using SetShowing, or it can be
+// shown on top of the GUI using Session.RunDialog.  The App sets Showing to false if the user
+// dismisses the dialog without using one of its buttons.
+type Dialog struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	buttons     Any1DField
+	dialogItems Any1DField
+	embodiment  StringField
+	showing     BooleanField
+	status      IntegerField
+	tag         StringField
+	title       StringField
+}
+
+// Creates a new Dialog with a title and a set of items.
+func NewDialog(title string, items ...Primitive) *Dialog {
+	return DialogWith{Title: title, DialogItems: items}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (dialog *Dialog) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	dialog.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Buttons, &dialog.buttons},
+			{key.FKey_DialogItems, &dialog.dialogItems},
+			{key.FKey_Embodiment, &dialog.embodiment},
+			{key.FKey_Showing, &dialog.showing},
+			{key.FKey_Status, &dialog.status},
+			{key.FKey_Tag, &dialog.tag},
+			{key.FKey_Title, &dialog.title},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (dialog *Dialog) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	nextIndex := locator.NextIndex()
+
+	switch nextIndex {
+	case 0:
+		return itemAt(dialog.buttons.Get(), locator.NextIndex())
+	case 1:
+		return itemAt(dialog.dialogItems.Get(), locator.NextIndex())
+	}
+
+	return nil
+}
+
+// Returns a string representation of this primitive:  the title.
+// Implements of fmt:Stringer interface.
+func (dialog *Dialog) String() string {
+	return dialog.title.Get()
+}
+
+// Returns the buttons for the user to respond with.
+func (dialog *Dialog) Buttons() []*Command {
+	items := dialog.buttons.Get()
+	buttons := make([]*Command, 0, len(items))
+	for _, item := range items {
+		if cmd, ok := item.(*Command); ok {
+			buttons = append(buttons, cmd)
+		}
+	}
+	return buttons
+}
+
+// Sets the buttons for the user to respond with.
+func (dialog *Dialog) SetButtons(buttons ...*Command) *Dialog {
+	items := make([]Primitive, len(buttons))
+	for i, button := range buttons {
+		items[i] = button
+	}
+	dialog.buttons.Set(items)
+	return dialog
+}
+
+// Returns the button issued by the user during the current Wait cycle, or nil if none was.
+func (dialog *Dialog) IssuedButton() *Command {
+	for _, button := range dialog.Buttons() {
+		if button.Issued() {
+			return button
+		}
+	}
+	return nil
+}
+
+// Returns the collection of primitives shown as the content of the dialog.
+func (dialog *Dialog) DialogItems() []Primitive {
+	return dialog.dialogItems.Get()
+}
+
+// Sets the collection of primitives shown as the content of the dialog.
+func (dialog *Dialog) SetDialogItems(items []Primitive) *Dialog {
+	dialog.dialogItems.Set(items)
+	return dialog
+}
+
+// Sets the collection of primitives (variadic argument list) shown as the content of the dialog.
+func (dialog *Dialog) SetDialogItemsVA(items ...Primitive) *Dialog {
+	dialog.dialogItems.Set(items)
+	return dialog
+}
+
+// Returns whether the dialog is being shown on the screen.
+func (dialog *Dialog) Showing() bool {
+	return dialog.showing.Get()
+}
+
+// Sets whether the dialog is being shown on the screen.
+func (dialog *Dialog) SetShowing(showing bool) *Dialog {
+	dialog.showing.Set(showing)
+	return dialog
+}
+
+// Returns the title of the dialog.
+func (dialog *Dialog) Title() string {
+	return dialog.title.Get()
+}
+
+// Sets the title of the dialog.
+func (dialog *Dialog) SetTitle(s string) *Dialog {
+	dialog.title.Set(s)
+	return dialog
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (dialog *Dialog) Embodiment() string {
+	return dialog.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (dialog *Dialog) SetEmbodiment(s string) *Dialog {
+	dialog.embodiment.Set(s)
+	return dialog
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (dialog *Dialog) Tag() string {
+	return dialog.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (dialog *Dialog) SetTag(s string) *Dialog {
+	dialog.tag.Set(s)
+	return dialog
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Dialog) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Dialog) SetStatus(i int) *Dialog {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Dialog) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Dialog) SetVisible(visible bool) *Dialog {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Dialog) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Dialog) SetEnabled(enabled bool) *Dialog {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Dialog) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Dialog) SetCollapsed(collapsed bool) *Dialog {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
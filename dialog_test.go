@@ -0,0 +1,57 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_DialogAttachedFields(t *testing.T) {
+	dialog := &Dialog{}
+	dialog.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, dialog.PrimitiveBase, "Buttons", "DialogItems", "Embodiment", "Showing", "Status", "Tag", "Title")
+}
+
+func Test_DialogMake(t *testing.T) {
+	ok := NewCommand("OK")
+	dialog := DialogWith{
+		Buttons:     []*Command{ok},
+		DialogItems: []Primitive{NewText("Really?")},
+		Title:       "Question",
+	}.Make()
+
+	if dialog.Title() != "Question" || dialog.String() != "Question" {
+		t.Error("could not initialize Title field")
+	}
+	if len(dialog.DialogItems()) != 1 {
+		t.Error("could not initialize DialogItems field")
+	}
+	if buttons := dialog.Buttons(); len(buttons) != 1 || buttons[0] != ok {
+		t.Error("could not initialize Buttons field")
+	}
+	if dialog.Showing() {
+		t.Error("dialog is showing.  Expecting it to be hidden initially")
+	}
+}
+
+func Test_DialogLocateNextDescendant(t *testing.T) {
+	ok := NewCommand("OK")
+	text := NewText("Really?")
+	dialog := DialogWith{Buttons: []*Command{NewCommand("Cancel"), ok}, DialogItems: []Primitive{text}}.Make()
+
+	if dialog.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 1))) != ok {
+		t.Error("unable to locate button")
+	}
+	if dialog.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1, 0))) != text {
+		t.Error("unable to locate dialog item")
+	}
+	if dialog.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1, 1))) != nil {
+		t.Error("expecting nil for an item out of range")
+	}
+}
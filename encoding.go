@@ -0,0 +1,249 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/prontogui/golib/key"
+)
+
+// Sets the field names known by the App, in the order of the codes it uses for them.  Updates
+// then refer to each of these fields by its code (its index in names) rather than its name,
+// which makes them a lot smaller.  Any other field is still referred to by name.
+func (s *Synchro) SetFieldNames(names []string) {
+	s.fieldNames = names
+	s.fieldCodes = make(map[string]int, len(names))
+	for code, name := range names {
+		s.fieldCodes[name] = code
+	}
+}
+
+// Sets the names of the fields supported by the App.  Any other field is left out of updates
+// sent to the App.  A nil list means all fields are supported, which is the default.
+func (s *Synchro) SetSupportedFields(names []string) {
+	s.supportedFields = makeNameSet(names)
+}
+
+// Sets the type names of the primitives supported by the App, such as "Command" or "Table".
+// Any other primitive is sent as an empty primitive, like Nothing, so the positions of the
+// primitives around it are preserved.  A nil list means all primitives are supported, which
+// is the default.
+func (s *Synchro) SetSupportedPrimitives(names []string) {
+	s.supportedPrimitives = makeNameSet(names)
+}
+
+// Sets whether the App can append points to a chart series, in which case only the new points
+// are sent.  This is off by default, and a session turns it on when the App claims the
+// points-append capability.
+func (s *Synchro) SetPointsAppend(enabled bool) {
+	s.pointsAppend = enabled
+}
+
+// Replaces the points egested in a partial update with just the points appended, in a map that
+// isn't keyed by field names, if the App supports it.  Otherwise all the points are sent.
+func (s *Synchro) resolvePoints(p *pointsEgest) any {
+	if s.pointsAppend {
+		return p.field.egestAppended()
+	}
+	return p.field.EgestValue()
+}
+
+func makeNameSet(names []string) map[string]bool {
+	if names == nil {
+		return nil
+	}
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
+
+// Returns the type name of a primitive, without any package or pointer.
+func primitiveTypeName(p Primitive) string {
+	t := reflect.TypeOf(p)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+func (s *Synchro) isSupportedPrimitive(p Primitive) bool {
+	return s.supportedPrimitives == nil || s.supportedPrimitives[primitiveTypeName(p)]
+}
+
+func (s *Synchro) isSupportedField(fieldname string) bool {
+	return s.supportedFields == nil || s.supportedFields[fieldname]
+}
+
+// Returns true if the App supports the primitive at pkey and all of its ancestors.
+func (s *Synchro) isSupportedAt(pkey key.PKey) bool {
+	if s.supportedPrimitives == nil {
+		return true
+	}
+
+	locator := key.NewPKeyLocator(pkey)
+	next := itemAt(s.primitives, locator.NextIndex())
+
+	for next != nil && s.isSupportedPrimitive(next) {
+		if locator.Located() {
+			return true
+		}
+		next = next.LocateNextDescendant(locator)
+	}
+
+	return false
+}
+
+// Returns the name or code to use for a field in updates sent to the App.
+func (s *Synchro) encodeFieldKey(fkey key.FKey) any {
+	return s.encodeFieldName(key.FieldnameFor(fkey))
+}
+
+func (s *Synchro) encodeFieldName(fieldname string) any {
+	if code, ok := s.fieldCodes[fieldname]; ok {
+		return code
+	}
+	return fieldname
+}
+
+// Gives access to the fields of a primitive.  This is implemented by PrimitiveBase.
+type fieldFinder interface {
+	findField(fkey key.FKey) Field
+}
+
+// Prepares the egested update of a primitive for sending to the App.  Fields and primitives
+// that the App doesn't support are left out, field names are replaced by codes if the App
+// uses them, and large blobs are handed off to resolveBlob.
+func (s *Synchro) encodePrimitive(p Primitive, update map[any]any) map[any]any {
+
+	if !s.isSupportedPrimitive(p) {
+		return map[any]any{}
+	}
+
+	finder, _ := p.(fieldFinder)
+
+	encoded := make(map[any]any, len(update))
+
+	for k, value := range update {
+		fieldname, ok := k.(string)
+		if !ok {
+			encoded[k] = s.encodeValue(value)
+			continue
+		}
+		if !s.isSupportedField(fieldname) {
+			continue
+		}
+
+		var field Field
+		if finder != nil {
+			field = finder.findField(key.FKeyFor(fieldname))
+		}
+
+		encoded[s.encodeFieldName(fieldname)] = s.encodeFieldValue(field, value)
+	}
+
+	return encoded
+}
+
+// Prepares an egested field value for sending to the App.  The primitives held by the field,
+// if any, are prepared with encodePrimitive.
+func (s *Synchro) encodeFieldValue(field Field, value any) any {
+
+	switch f := field.(type) {
+	case *AnyField:
+		if m, ok := value.(map[any]any); ok && f.p != nil {
+			return s.encodePrimitive(f.p, m)
+		}
+	case *Any1DField:
+		if l, ok := value.([]any); ok && len(l) == len(f.ary) {
+			for i, item := range l {
+				l[i] = s.encodeChild(f.ary[i], item)
+			}
+			return l
+		}
+	case *Any2DField:
+		if rows, ok := value.([][]any); ok && len(rows) == len(f.ary) {
+			for i, row := range rows {
+				if len(row) != len(f.ary[i]) {
+					return s.encodeValue(value)
+				}
+				for j, item := range row {
+					row[j] = s.encodeChild(f.ary[i][j], item)
+				}
+			}
+			return rows
+		}
+	}
+
+	return s.encodeValue(value)
+}
+
+func (s *Synchro) encodeChild(p Primitive, value any) any {
+	if m, ok := value.(map[any]any); ok {
+		return s.encodePrimitive(p, m)
+	}
+	return s.encodeValue(value)
+}
+
+// Walks an egested value of unknown structure to prepare it for sending to the App.  This is
+// the fallback for primitives that don't give access to their fields.
+func (s *Synchro) encodeValue(value any) any {
+
+	switch v := value.(type) {
+	case *blobEgest:
+		return s.resolveBlob(v)
+	case *pointsEgest:
+		return s.resolvePoints(v)
+	case map[any]any:
+		m := make(map[any]any, len(v))
+		for k, item := range v {
+			if fieldname, ok := k.(string); ok {
+				if !s.isSupportedField(fieldname) {
+					continue
+				}
+				k = s.encodeFieldName(fieldname)
+			}
+			m[k] = s.encodeValue(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = s.encodeValue(item)
+		}
+	case [][]any:
+		for _, row := range v {
+			s.encodeValue(row)
+		}
+	}
+
+	return value
+}
+
+// Replaces any field codes in an update received from the App with field names.
+func (s *Synchro) decodeFieldKeys(update map[any]any) (map[any]any, error) {
+
+	if s.fieldNames == nil {
+		return update, nil
+	}
+
+	m := make(map[any]any, len(update))
+	for k, v := range update {
+		if _, ok := k.(string); ok {
+			m[k] = v
+			continue
+		}
+		code, err := ConvertAnyToInt(k)
+		if err != nil || code < 0 || code >= len(s.fieldNames) {
+			return nil, unknownFieldError(fmt.Sprint(k), "invalid field code")
+		}
+		m[s.fieldNames[code]] = v
+	}
+	return m, nil
+}
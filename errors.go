@@ -0,0 +1,103 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prontogui/golib/key"
+)
+
+// Defined errors describing why an update from the App could not be ingested.  These are the
+// reasons given by an IngestError and can be tested for using errors.Is.
+var (
+	// The update is not structured the way the protocol requires.
+	ErrMalformedUpdate = errors.New("malformed update")
+
+	// The update refers to a primitive that doesn't exist.
+	ErrPrimitiveNotFound = errors.New("primitive not found")
+
+	// The update refers to a field that doesn't exist in the primitive.
+	ErrUnknownField = errors.New("unknown field")
+
+	// The update has a value that is not valid for the field.
+	ErrInvalidValue = errors.New("invalid value")
+)
+
+// IngestError describes an update from the App that could not be ingested.  It is returned
+// from Synchro.IngestUpdate, and from Wait, WaitOrCancel, or Update, and can be retrieved using
+// errors.As.  The session continues after an IngestError.
+type IngestError struct {
+	// The primitive that was updated, or nil if not known.
+	PKey key.PKey
+
+	// The name of the field that was updated, or empty if not known.
+	Field string
+
+	// The reason the update could not be ingested.  This is, or wraps, one of the defined
+	// errors such as ErrInvalidValue.
+	Reason error
+}
+
+// Returns a description of the error.  Implements the error interface.
+func (e *IngestError) Error() string {
+	switch {
+	case e.PKey == nil:
+		return e.Reason.Error()
+	case e.Field != "":
+		return fmt.Sprintf("field %s of primitive at pkey %v: %v", e.Field, e.PKey, e.Reason)
+	}
+	return fmt.Sprintf("primitive at pkey %v: %v", e.PKey, e.Reason)
+}
+
+// Returns the reason for the error so that errors.Is can test for the defined errors.
+func (e *IngestError) Unwrap() error {
+	return e.Reason
+}
+
+// A reason for an IngestError that is one of the defined errors but is described by its
+// details alone.
+type ingestReason struct {
+	err     error
+	details error
+}
+
+func (r *ingestReason) Error() string {
+	return r.details.Error()
+}
+
+func (r *ingestReason) Unwrap() []error {
+	return []error{r.err, r.details}
+}
+
+// Returns an IngestError for a malformed update with the given details.
+func malformedUpdateError(details string) *IngestError {
+	return &IngestError{Reason: &ingestReason{ErrMalformedUpdate, errors.New(details)}}
+}
+
+// Returns an IngestError for a field that doesn't exist, with the given details.
+func unknownFieldError(fieldname string, details string) *IngestError {
+	return &IngestError{Field: fieldname, Reason: &ingestReason{ErrUnknownField, errors.New(details)}}
+}
+
+// Returns an IngestError for a field whose value could not be ingested.
+func invalidValueError(fieldname string, err error) *IngestError {
+	return &IngestError{Field: fieldname, Reason: &ingestReason{ErrInvalidValue, err}}
+}
+
+// Returns err as an IngestError for the primitive at pkey.  The pkey is filled in if the error
+// is already an IngestError that doesn't have one, otherwise err is wrapped.
+func locateIngestError(pkey key.PKey, err error) *IngestError {
+	if ie, ok := err.(*IngestError); ok {
+		if ie.PKey == nil {
+			ie.PKey = pkey
+		}
+		return ie
+	}
+	return &IngestError{PKey: pkey, Reason: &ingestReason{ErrInvalidValue, err}}
+}
@@ -76,3 +76,9 @@ func (f *EventField) IngestValue(value any) error {
 	}
 	return nil
 }
+
+// Forgets the event ingested during the current Wait cycle, such as when the primitive rejects
+// the update that came with it.  Issued then returns false.
+func (f *EventField) revoke() {
+	f.validTimestamp = false
+}
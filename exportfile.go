@@ -7,6 +7,8 @@
 package golib
 
 import (
+	"io"
+
 	"github.com/prontogui/golib/key"
 )
 
@@ -83,6 +85,22 @@ func (ef *ExportFile) SetData(d []byte) *ExportFile {
 	return ef
 }
 
+// Sets the blob of data for the file to export by reading everything from r.
+func (ef *ExportFile) SetDataReader(r io.Reader) error {
+	d, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	ef.data.Set(d)
+	return nil
+}
+
+// Returns the fraction (0 to 1) of the file's data sent so far to the app.  Large files
+// are sent in chunks over successive updates.
+func (ef *ExportFile) Progress() float64 {
+	return ef.data.Progress()
+}
+
 // Clears the exported data and the exported flag.
 func (ef *ExportFile) Reset() {
 	ef.data.Set([]byte{})
@@ -7,6 +7,7 @@
 package golib
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/prontogui/golib/key"
@@ -79,3 +80,16 @@ func Test_ExportReset(t *testing.T) {
 		t.Error("exported flag wasn't set to false")
 	}
 }
+
+func Test_ExportFileSetDataReader(t *testing.T) {
+	ef := &ExportFile{}
+
+	err := ef.SetDataReader(bytes.NewReader([]byte{1, 2, 3}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(ef.Data(), []byte{1, 2, 3}) {
+		t.Error("data was not read from the reader")
+	}
+}
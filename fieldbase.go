@@ -7,6 +7,8 @@
 package golib
 
 import (
+	"slices"
+
 	"github.com/prontogui/golib/key"
 )
 
@@ -51,3 +53,19 @@ func (f *FieldBase) OnSet(structural bool) {
 		f.onset(f.pkey, f.fkey, structural)
 	}
 }
+
+// Returns true if two slices have equal elements.  A slice is never equal to another slice
+// sharing the same underlying array, since its elements might have been modified in place
+// and there's no way to tell.
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) == 0 {
+		return true
+	}
+	if &a[0] == &b[0] {
+		return false
+	}
+	return slices.Equal(a, b)
+}
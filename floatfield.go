@@ -0,0 +1,66 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+
+	"github.com/prontogui/golib/key"
+)
+
+type FloatField struct {
+	FieldBase
+	f float64
+}
+
+func (f *FloatField) Get() float64 {
+	return f.f
+}
+
+func (f *FloatField) Set(v float64) {
+	if v == f.f {
+		return
+	}
+	f.f = v
+	f.OnSet(false)
+}
+
+func (f *FloatField) PrepareForUpdates(fkey key.FKey, pkey key.PKey, fieldPKeyIndex int, onset key.OnSetFunction, etsprovider EventTimestampProvider) (isContainer bool) {
+	f.StashUpdateInfo(fkey, pkey, fieldPKeyIndex, onset, etsprovider)
+	return false
+}
+
+func (f *FloatField) EgestValue() any {
+	return f.f
+}
+
+func ConvertAnyToFloat(value any) (float64, error) {
+
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	}
+
+	// Whole numbers may be encoded as integers by the App
+	i, err := ConvertAnyToInt(value)
+	if err != nil {
+		return 0, errors.New("unable to convert value (any) to field value")
+	}
+	return float64(i), nil
+}
+
+func (f *FloatField) IngestValue(value any) error {
+
+	v, err := ConvertAnyToFloat(value)
+	if err == nil {
+		f.f = v
+	}
+
+	return err
+}
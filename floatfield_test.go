@@ -0,0 +1,79 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_FloatSetAndGet(t *testing.T) {
+	f := FloatField{}
+
+	f.Set(3.25)
+
+	if f.Get() != 3.25 {
+		t.Fatal("cannot set float and get the same value back.")
+	}
+}
+
+func Test_FloatPrepareForUpdates(t *testing.T) {
+	f := FloatField{}
+
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	verifyFieldPreppedForUpdate(t, &f.FieldBase)
+
+	f.Set(1.5)
+
+	if !testOnsetCalled {
+		t.Error("onset was not called")
+	}
+}
+
+func Test_FloatEgestValue(t *testing.T) {
+	f := FloatField{}
+	f.Set(-0.125)
+	v, ok := f.EgestValue().(float64)
+	if !ok {
+		t.Fatal("cannot convert return value to float64")
+	}
+	if v != -0.125 {
+		t.Fatal("incorrect value returned")
+	}
+}
+
+func Test_FloatIngestUpdate(t *testing.T) {
+
+	f := FloatField{}
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	verifyIngestUpdateSuccessful(t, f.IngestValue(float64(2.5)), func() bool { return f.Get() == 2.5 })
+	verifyIngestUpdateSuccessful(t, f.IngestValue(float32(-2.5)), func() bool { return f.Get() == -2.5 })
+	verifyIngestUpdateSuccessful(t, f.IngestValue(uint8(34)), func() bool { return f.Get() == 34 })
+	verifyIngestUpdateSuccessful(t, f.IngestValue(int64(-34)), func() bool { return f.Get() == -34 })
+}
+
+func Test_FloatIngestUpdateInvalid(t *testing.T) {
+
+	f := FloatField{}
+	err := f.IngestValue("1.5")
+	verifyIngestUpdateInvalid(t, err)
+}
+
+func Test_FloatSetSameValue(t *testing.T) {
+	f := FloatField{}
+	f.Set(4.5)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set(4.5)
+
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+}
@@ -82,7 +82,7 @@ func (folder *Folder) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 		return folder.LabelItem()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a string representation of this primitive.
@@ -76,7 +76,7 @@ func (folderItem *FolderItem) LocateNextDescendant(locator *key.PKeyLocator) Pri
 		return folderItem.Item()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a string representation of this primitive.
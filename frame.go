@@ -82,12 +82,12 @@ func (frame *Frame) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 
 	switch nextIndex {
 	case 0:
-		return frame.FrameItems()[locator.NextIndex()]
+		return itemAt(frame.FrameItems(), locator.NextIndex())
 	case 1:
 		return frame.Icon()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a JSON string specifying the embodiment to use for this primitive.
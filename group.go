@@ -67,10 +67,10 @@ func (grp *Group) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsp
 // and normally should not be called by users of the library.
 func (grp *Group) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 	if locator.NextIndex() == 0 {
-		return grp.GroupItems()[locator.NextIndex()]
+		return itemAt(grp.GroupItems(), locator.NextIndex())
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a JSON string specifying the embodiment to use for this primitive.
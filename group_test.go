@@ -107,3 +107,14 @@ func Test_GroupLocateChildPrimitive(t *testing.T) {
 		t.Fatal("LocateNextDescendant doesn't return a child for pkey 1.")
 	}
 }
+
+func Test_GroupLocateChildPrimitiveOutOfRange(t *testing.T) {
+
+	grp := GroupWith{GroupItems: []Primitive{CommandWith{Label: "a"}.Make()}}.Make()
+
+	for _, pkey := range []key.PKey{key.NewPKey(0, 1), key.NewPKey(0, -1), key.NewPKey(1, 0), key.NewPKey(0)} {
+		if grp.LocateNextDescendant(key.NewPKeyLocator(pkey)) != nil {
+			t.Errorf("LocateNextDescendant returned a child for invalid pkey %v.", pkey)
+		}
+	}
+}
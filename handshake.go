@@ -0,0 +1,68 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/pgcomm"
+)
+
+// Capabilities that a client can claim in the handshake at the start of a session, using the
+// pgcomm.CapabilitiesMetadataKey metadata key.  Each one turns on an optional part of the
+// protocol.
+const (
+	// Large blobs are sent in chunks over successive updates.
+	CapabilityBlobChunks = "blob-chunks"
+
+	// Blobs are kept in the App's cache and referred to by hash once sent.
+	CapabilityBlobCache = "blob-cache"
+
+	// Fields are referred to by their index in the client's list of field names.
+	CapabilityFieldCodes = "field-codes"
+
+	// Only the points added to a chart series are sent, for the client to append.
+	CapabilityPointsAppend = "points-append"
+)
+
+// The capabilities supported by this library.
+var serverCapabilities = []string{CapabilityBlobChunks, CapabilityBlobCache, CapabilityFieldCodes, CapabilityPointsAppend}
+
+// Metadata keys used by a client in the handshake to describe what it supports.  The names
+// can be given as separate values or separated by commas.
+const (
+	// The field names known by the client.  Other fields are left out of updates.  With the
+	// field-codes capability, fields are referred to by their index in this list.
+	FieldNamesMetadataKey = "pg-field-names"
+
+	// The type names of the primitives known by the client, such as "Command" or "Table".
+	// Other primitives are sent as empty primitives, like Nothing.
+	PrimitivesMetadataKey = "pg-primitives"
+)
+
+// Configures the session according to the outcome of the handshake with the client.  Large
+// blobs are sent in chunks of blobChunkSize if the client supports it.
+func (s *_Session) applyHandshake(apicall *pgcomm.StreamingAPICall, blobChunkSize int) {
+
+	s.incompatible = apicall.Incompatible
+
+	if apicall.HasCapability(CapabilityBlobChunks) {
+		s.synchro.SetBlobChunkSize(blobChunkSize)
+	}
+
+	s.synchro.SetBlobCaching(apicall.HasCapability(CapabilityBlobCache))
+	s.synchro.SetPointsAppend(apicall.HasCapability(CapabilityPointsAppend))
+
+	if names := pgcomm.SplitMetadataValues(apicall.Metadata.Get(FieldNamesMetadataKey)); len(names) > 0 {
+		s.synchro.SetSupportedFields(names)
+		if apicall.HasCapability(CapabilityFieldCodes) {
+			s.synchro.SetFieldNames(names)
+		}
+	}
+
+	if names := pgcomm.SplitMetadataValues(apicall.Metadata.Get(PrimitivesMetadataKey)); len(names) > 0 {
+		s.synchro.SetSupportedPrimitives(names)
+	}
+}
@@ -7,6 +7,10 @@
 package golib
 
 import (
+	"bytes"
+	"errors"
+	"io"
+
 	"github.com/prontogui/golib/key"
 )
 
@@ -86,6 +90,22 @@ func (ifile *ImportFile) ImportData(d []byte) *ImportFile {
 	return ifile
 }
 
+// Returns a reader for the imported data.  Returns an error if the file hasn't been
+// imported yet.
+func (ifile *ImportFile) Open() (io.Reader, error) {
+	if !ifile.imported.Get() {
+		return nil, errors.New("file has not been imported")
+	}
+	return bytes.NewReader(ifile.data.Get()), nil
+}
+
+// Returns the fraction (0 to 1) of the file's data received so far from the app.  Large
+// files are received in chunks over successive updates, each of which is returned by Wait,
+// so this can be used to report progress while importing.
+func (ifile *ImportFile) Progress() float64 {
+	return ifile.data.Progress()
+}
+
 // Clears the imported data and the imported flag.
 func (ifile *ImportFile) Reset() {
 	ifile.data.Set([]byte{})
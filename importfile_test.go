@@ -7,6 +7,8 @@
 package golib
 
 import (
+	"bytes"
+	"io"
 	"testing"
 
 	"github.com/prontogui/golib/key"
@@ -97,3 +99,42 @@ func Test_ImportReset(t *testing.T) {
 		t.Error("imported flag wasn't set to false")
 	}
 }
+
+func Test_ImportFileOpen(t *testing.T) {
+	impf := &ImportFile{}
+
+	_, err := impf.Open()
+	if err == nil {
+		t.Fatal("expecting an error when opening a file that hasn't been imported")
+	}
+
+	impf.ImportData([]byte{1, 2, 3})
+
+	r, err := impf.Open()
+	if err != nil {
+		t.Fatalf("unexpected error opening imported file: %v", err)
+	}
+
+	data, _ := io.ReadAll(r)
+	if !bytes.Equal(data, []byte{1, 2, 3}) {
+		t.Error("reader did not return the imported data")
+	}
+}
+
+func Test_ImportFileProgress(t *testing.T) {
+	impf := &ImportFile{}
+	impf.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+
+	if impf.Progress() != 0 {
+		t.Error("expecting no progress before importing")
+	}
+
+	err := impf.IngestUpdate(map[any]any{"Data": map[any]any{"Offset": uint64(0), "Size": uint64(4), "Bytes": []byte{1}}})
+	if err != nil {
+		t.Fatalf("unexpected error ingesting chunk: %v", err)
+	}
+
+	if impf.Progress() != 0.25 {
+		t.Errorf("progress is %v.  Expecting 0.25", impf.Progress())
+	}
+}
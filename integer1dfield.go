@@ -22,7 +22,12 @@ func (f *Integer1DField) Get() []int {
 	return f.ia
 }
 
+// Sets the integers.  Nothing is sent to the App if the integers are equal to the current
+// integers, unless it's the same slice, since it might have been modified in place.
 func (f *Integer1DField) Set(ia []int) {
+	if equalSlices(ia, f.ia) {
+		return
+	}
 	f.ia = ia
 	f.OnSet(false)
 }
@@ -116,3 +116,19 @@ func Test_Integer1DField_PrepareForUpdates(t *testing.T) {
 		t.Error("Expected isContainer to be false")
 	}
 }
+
+func Test_Integer1DField_SetSameValue(t *testing.T) {
+	f := Integer1DField{}
+	f.Set([]int{1, 2, 3})
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set([]int{1, 2, 3})
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+
+	f.Set([]int{1, 2})
+	if !testOnsetCalled {
+		t.Error("onset was not called when the value changed")
+	}
+}
@@ -22,6 +22,9 @@ func (f *IntegerField) Get() int {
 }
 
 func (f *IntegerField) Set(i int) {
+	if i == f.i {
+		return
+	}
 	f.i = i
 	f.OnSet(false)
 }
@@ -107,3 +107,15 @@ func Test_IntegerIngestUpdateInvalid(t *testing.T) {
 	err := f.IngestValue(false)
 	verifyIngestUpdateInvalid(t, err)
 }
+
+func Test_IntegerSetSameValue(t *testing.T) {
+	f := IntegerField{}
+	f.Set(42)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set(42)
+
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+}
@@ -16,54 +16,107 @@ const (
 const (
 
 	// ADD NEW FIELDS TO THIS BLOCK - ALPHABETICAL ORDER PLEASE!
-	FKey_Checked FKey = iota
+	FKey_BarSeries FKey = iota
+	FKey_Buttons
+	FKey_Categories
+	FKey_Changing
+	FKey_Checked
 	FKey_Choice
 	FKey_Choices
 	FKey_ChoiceLabels
 	FKey_CommandIssued
+	FKey_Committed
 	FKey_Content
 	FKey_Data
+	FKey_Date
+	FKey_DateTime
+	FKey_DecimalPlaces
+	FKey_DialogItems
+	FKey_DurationMs
+	FKey_Earliest
 	FKey_Embodiment
+	FKey_ErrorMessage
 	FKey_Expanded
 	FKey_Exported
 	FKey_FrameItems
 	FKey_GroupItems
 	FKey_HeaderRow
+	FKey_High
 	FKey_Icon
 	FKey_IconID
 	FKey_ID
 	FKey_Image
 	FKey_Imported
+	FKey_Indeterminate
 	FKey_Issued
 	FKey_Item
+	FKey_Key
+	FKey_KeyPressed
+	FKey_Keys
 	FKey_Label
 	FKey_LabelItem
+	FKey_Latest
 	FKey_LeadingItem
+	FKey_Legend
 	FKey_Level
+	FKey_LineSeries
+	FKey_Link
+	FKey_LinkClicked
 	FKey_ListItems
+	FKey_Low
 	FKey_MainItem
+	FKey_Markdown
+	FKey_MaxLength
+	FKey_MaxLines
+	FKey_MaxValue
+	FKey_MenuItemIssued
+	FKey_MenuItems
+	FKey_MenuTarget
+	FKey_Message
+	FKey_MinValue
 	FKey_ModelFolder
 	FKey_ModelItem
 	FKey_ModelRow
+	FKey_Multiline
 	FKey_Name
+	FKey_NotificationItems
 	FKey_NumericEntry
+	FKey_Orientation
+	FKey_PageItems
+	FKey_Password
 	FKey_PeriodMs
+	FKey_Placeholder
+	FKey_Points
+	FKey_ReadOnly
 	FKey_Ref
 	FKey_Rows
+	FKey_ScatterSeries
 	FKey_SelectedItems
 	FKey_SelectedRows
+	FKey_SelectedTab
 	FKey_SelectionChanged
 	FKey_SelectionMode
+	FKey_Shortcut
 	FKey_Showing
 	FKey_State
 	FKey_Status
+	FKey_Step
 	FKey_SubItem
+	FKey_SubmenuItems
+	FKey_Submitted
+	FKey_TabChanged
+	FKey_TabItems
 	FKey_Tag
 	FKey_TextEntry
+	FKey_Time
 	FKey_TimerFired
+	FKey_TimeZone
 	FKey_Title
 	FKey_TrailingItem
 	FKey_ValidExtensions
+	FKey_Value
+	FKey_XAxis
+	FKey_YAxis
 
 	// RESERVED CONSTANT
 	FKey_MAXIMUMKEYS
@@ -76,54 +129,107 @@ func init() {
 	_fkeyToName = make([]string, FKey_MAXIMUMKEYS)
 
 	// ADD NEW FIELDS TO THIS BLOCK - ALPHABETICAL ORDER PLEASE!
+	_fkeyToName[FKey_BarSeries] = "BarSeries"
+	_fkeyToName[FKey_Buttons] = "Buttons"
+	_fkeyToName[FKey_Categories] = "Categories"
+	_fkeyToName[FKey_Changing] = "Changing"
 	_fkeyToName[FKey_Checked] = "Checked"
 	_fkeyToName[FKey_Choice] = "Choice"
 	_fkeyToName[FKey_Choices] = "Choices"
 	_fkeyToName[FKey_ChoiceLabels] = "ChoiceLabels"
 	_fkeyToName[FKey_CommandIssued] = "CommandIssued"
+	_fkeyToName[FKey_Committed] = "Committed"
 	_fkeyToName[FKey_Content] = "Content"
 	_fkeyToName[FKey_Data] = "Data"
+	_fkeyToName[FKey_Date] = "Date"
+	_fkeyToName[FKey_DateTime] = "DateTime"
+	_fkeyToName[FKey_DecimalPlaces] = "DecimalPlaces"
+	_fkeyToName[FKey_DialogItems] = "DialogItems"
+	_fkeyToName[FKey_DurationMs] = "DurationMs"
+	_fkeyToName[FKey_Earliest] = "Earliest"
 	_fkeyToName[FKey_Embodiment] = "Embodiment"
+	_fkeyToName[FKey_ErrorMessage] = "ErrorMessage"
 	_fkeyToName[FKey_Expanded] = "Expanded"
 	_fkeyToName[FKey_Exported] = "Exported"
 	_fkeyToName[FKey_FrameItems] = "FrameItems"
 	_fkeyToName[FKey_GroupItems] = "GroupItems"
 	_fkeyToName[FKey_HeaderRow] = "HeaderRow"
+	_fkeyToName[FKey_High] = "High"
 	_fkeyToName[FKey_Icon] = "Icon"
 	_fkeyToName[FKey_IconID] = "IconID"
 	_fkeyToName[FKey_ID] = "ID"
 	_fkeyToName[FKey_Image] = "Image"
 	_fkeyToName[FKey_Imported] = "Imported"
+	_fkeyToName[FKey_Indeterminate] = "Indeterminate"
 	_fkeyToName[FKey_Issued] = "Issued"
 	_fkeyToName[FKey_Item] = "Item"
+	_fkeyToName[FKey_Key] = "Key"
+	_fkeyToName[FKey_KeyPressed] = "KeyPressed"
+	_fkeyToName[FKey_Keys] = "Keys"
 	_fkeyToName[FKey_Label] = "Label"
 	_fkeyToName[FKey_LabelItem] = "LabelItem"
+	_fkeyToName[FKey_Latest] = "Latest"
 	_fkeyToName[FKey_LeadingItem] = "LeadingItem"
+	_fkeyToName[FKey_Legend] = "Legend"
 	_fkeyToName[FKey_Level] = "Level"
+	_fkeyToName[FKey_LineSeries] = "LineSeries"
+	_fkeyToName[FKey_Link] = "Link"
+	_fkeyToName[FKey_LinkClicked] = "LinkClicked"
 	_fkeyToName[FKey_ListItems] = "ListItems"
+	_fkeyToName[FKey_Low] = "Low"
 	_fkeyToName[FKey_MainItem] = "MainItem"
+	_fkeyToName[FKey_Markdown] = "Markdown"
+	_fkeyToName[FKey_MaxLength] = "MaxLength"
+	_fkeyToName[FKey_MaxLines] = "MaxLines"
+	_fkeyToName[FKey_MaxValue] = "MaxValue"
+	_fkeyToName[FKey_MenuItemIssued] = "MenuItemIssued"
+	_fkeyToName[FKey_MenuItems] = "MenuItems"
+	_fkeyToName[FKey_MenuTarget] = "MenuTarget"
+	_fkeyToName[FKey_Message] = "Message"
+	_fkeyToName[FKey_MinValue] = "MinValue"
 	_fkeyToName[FKey_ModelFolder] = "ModelFolder"
 	_fkeyToName[FKey_ModelItem] = "ModelItem"
 	_fkeyToName[FKey_ModelRow] = "ModelRow"
+	_fkeyToName[FKey_Multiline] = "Multiline"
 	_fkeyToName[FKey_Name] = "Name"
+	_fkeyToName[FKey_NotificationItems] = "NotificationItems"
 	_fkeyToName[FKey_NumericEntry] = "NumericEntry"
+	_fkeyToName[FKey_Orientation] = "Orientation"
+	_fkeyToName[FKey_PageItems] = "PageItems"
+	_fkeyToName[FKey_Password] = "Password"
 	_fkeyToName[FKey_PeriodMs] = "PeriodMs"
+	_fkeyToName[FKey_Placeholder] = "Placeholder"
+	_fkeyToName[FKey_Points] = "Points"
+	_fkeyToName[FKey_ReadOnly] = "ReadOnly"
 	_fkeyToName[FKey_Ref] = "Ref"
 	_fkeyToName[FKey_Rows] = "Rows"
+	_fkeyToName[FKey_ScatterSeries] = "ScatterSeries"
 	_fkeyToName[FKey_SelectedItems] = "SelectedItems"
 	_fkeyToName[FKey_SelectedRows] = "SelectedRows"
+	_fkeyToName[FKey_SelectedTab] = "SelectedTab"
 	_fkeyToName[FKey_SelectionChanged] = "SelectionChanged"
 	_fkeyToName[FKey_SelectionMode] = "SelectionMode"
+	_fkeyToName[FKey_Shortcut] = "Shortcut"
 	_fkeyToName[FKey_Showing] = "Showing"
 	_fkeyToName[FKey_State] = "State"
 	_fkeyToName[FKey_Status] = "Status"
+	_fkeyToName[FKey_Step] = "Step"
 	_fkeyToName[FKey_SubItem] = "SubItem"
+	_fkeyToName[FKey_SubmenuItems] = "SubmenuItems"
+	_fkeyToName[FKey_Submitted] = "Submitted"
+	_fkeyToName[FKey_TabChanged] = "TabChanged"
+	_fkeyToName[FKey_TabItems] = "TabItems"
 	_fkeyToName[FKey_Tag] = "Tag"
 	_fkeyToName[FKey_TextEntry] = "TextEntry"
+	_fkeyToName[FKey_Time] = "Time"
 	_fkeyToName[FKey_TimerFired] = "TimerFired"
+	_fkeyToName[FKey_TimeZone] = "TimeZone"
 	_fkeyToName[FKey_Title] = "Title"
 	_fkeyToName[FKey_TrailingItem] = "TrailingItem"
 	_fkeyToName[FKey_ValidExtensions] = "ValidExtensions"
+	_fkeyToName[FKey_Value] = "Value"
+	_fkeyToName[FKey_XAxis] = "XAxis"
+	_fkeyToName[FKey_YAxis] = "YAxis"
 
 	_nameToFKey = make(map[string]FKey, FKey_MAXIMUMKEYS)
 
@@ -96,11 +96,12 @@ func NewPKeyLocator(pkey PKey) *PKeyLocator {
 	return loc
 }
 
-// Advance the level and return the index at that level.
+// Advance the level and return the index at that level.  Returns INVALID_INDEX if
+// the locator is already at the last level.
 func (loc *PKeyLocator) NextIndex() int {
 
 	if loc.LocationLevel >= (len(loc.PKey) - 1) {
-		panic("PKeyLocator went out of bounds")
+		return INVALID_INDEX
 	}
 
 	loc.LocationLevel = loc.LocationLevel + 1
@@ -190,3 +190,16 @@ func Test_Len(t *testing.T) {
 	testfunc(NewPKey(9), 1)
 	testfunc(NewPKey(9, 3, 4), 3)
 }
+
+func Test_LocatorNextIndexOutOfBounds(t *testing.T) {
+
+	locator := NewPKeyLocator(NewPKey(4))
+
+	if locator.NextIndex() != 4 {
+		t.Fatal("NextIndex didn't return the index at level 0.")
+	}
+
+	if locator.NextIndex() != INVALID_INDEX {
+		t.Fatal("NextIndex didn't return an invalid index past the last level.")
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/prontogui/golib/key"
+)
+
+// Receives the keys pressed by the user while the App has focus, such as for a kiosk that
+// is driven by a keyboard or keypad.
+type KeyEventWith struct {
+	Embodiment string
+	Keys       []string
+	Status     int
+	Tag        string
+}
+
+// Makes a new KeyEvent with specified field values.
+func (w KeyEventWith) Make() *KeyEvent {
+	ke := &KeyEvent{}
+	ke.embodiment.Set(w.Embodiment)
+	ke.keys.Set(normalizeKeys(w.Keys))
+	ke.status.Set(w.Status)
+	ke.tag.Set(w.Tag)
+	return ke
+}
+
+// Receives the keys pressed by the user while the App has focus, such as for a kiosk that
+// is driven by a keyboard or keypad.  It isn't shown.  When a key is pressed, Wait returns
+// the KeyEvent and Pressed returns true.  No keys are received while it is disabled.
+//
+// Keys are named by any modifiers followed by the key itself, joined with a plus sign, such
+// as "Ctrl+Shift+S", "F5", or "Escape".  See NormalizeKey for the names used.
+type KeyEvent struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment StringField
+	key        StringField
+	keyPressed EventField
+	keys       String1DField
+	status     IntegerField
+	tag        StringField
+}
+
+// Creates a new KeyEvent that receives the given keys, or every key if none are given.
+// Keys that can't be named are kept as given and never received.  Use SetKeys to check them.
+func NewKeyEvent(keys ...string) *KeyEvent {
+	return KeyEventWith{Keys: keys}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (ke *KeyEvent) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	ke.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &ke.embodiment},
+			{key.FKey_Key, &ke.key},
+			{key.FKey_KeyPressed, &ke.keyPressed},
+			{key.FKey_Keys, &ke.keys},
+			{key.FKey_Status, &ke.status},
+			{key.FKey_Tag, &ke.tag},
+		}
+	})
+}
+
+// Returns a string representation of this primitive:  the last key pressed.
+// Implements of fmt:Stringer interface.
+func (ke *KeyEvent) String() string {
+	return ke.Key()
+}
+
+// Returns true if a key was pressed during the current Wait cycle.
+func (ke *KeyEvent) Pressed() bool {
+	return ke.keyPressed.Issued()
+}
+
+// Returns the last key pressed, such as "Ctrl+S".  Keys named differently by the App are
+// returned as named by NormalizeKey.
+func (ke *KeyEvent) Key() string {
+	k := ke.key.Get()
+	if normalized, err := NormalizeKey(k); err == nil {
+		return normalized
+	}
+	return k
+}
+
+// Returns the keys to receive, or empty to receive every key.
+func (ke *KeyEvent) Keys() []string {
+	return ke.keys.Get()
+}
+
+// Sets the keys to receive, or empty to receive every key.  Returns an error, leaving the
+// keys unchanged, if a key can't be named.
+func (ke *KeyEvent) SetKeys(keys []string) error {
+	for _, k := range keys {
+		if _, err := NormalizeKey(k); err != nil {
+			return err
+		}
+	}
+	ke.keys.Set(normalizeKeys(keys))
+	return nil
+}
+
+// Sets the keys (variadic argument list) to receive, or none to receive every key.  Returns
+// an error, leaving the keys unchanged, if a key can't be named.
+func (ke *KeyEvent) SetKeysVA(keys ...string) error {
+	return ke.SetKeys(keys)
+}
+
+// Returns the keys named as by NormalizeKey, keeping any that can't be named as given.
+func normalizeKeys(keys []string) []string {
+	normalized := make([]string, len(keys))
+	for i, k := range keys {
+		if n, err := NormalizeKey(k); err == nil {
+			normalized[i] = n
+		} else {
+			normalized[i] = k
+		}
+	}
+	return normalized
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (ke *KeyEvent) Embodiment() string {
+	return ke.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (ke *KeyEvent) SetEmbodiment(s string) *KeyEvent {
+	ke.embodiment.Set(s)
+	return ke
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (ke *KeyEvent) Tag() string {
+	return ke.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (ke *KeyEvent) SetTag(s string) *KeyEvent {
+	ke.tag.Set(s)
+	return ke
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *KeyEvent) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *KeyEvent) SetStatus(i int) *KeyEvent {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *KeyEvent) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *KeyEvent) SetVisible(visible bool) *KeyEvent {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *KeyEvent) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *KeyEvent) SetEnabled(enabled bool) *KeyEvent {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *KeyEvent) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *KeyEvent) SetCollapsed(collapsed bool) *KeyEvent {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
+
+// The names of modifier keys, in the order they are given in a key.
+var keyModifiers = []string{"Ctrl", "Alt", "Shift", "Meta"}
+
+// Other names for modifier keys.
+var keyModifierAliases = map[string]string{
+	"ctrl":    "Ctrl",
+	"control": "Ctrl",
+	"alt":     "Alt",
+	"option":  "Alt",
+	"shift":   "Shift",
+	"meta":    "Meta",
+	"cmd":     "Meta",
+	"command": "Meta",
+	"super":   "Meta",
+	"win":     "Meta",
+}
+
+// The names of keys other than characters and function keys, including other names for them.
+var keyNames = map[string]string{
+	"arrowdown":  "ArrowDown",
+	"arrowleft":  "ArrowLeft",
+	"arrowright": "ArrowRight",
+	"arrowup":    "ArrowUp",
+	"backspace":  "Backspace",
+	"del":        "Delete",
+	"delete":     "Delete",
+	"down":       "ArrowDown",
+	"end":        "End",
+	"enter":      "Enter",
+	"esc":        "Escape",
+	"escape":     "Escape",
+	"home":       "Home",
+	"ins":        "Insert",
+	"insert":     "Insert",
+	"left":       "ArrowLeft",
+	"pagedown":   "PageDown",
+	"pageup":     "PageUp",
+	"return":     "Enter",
+	"right":      "ArrowRight",
+	"space":      "Space",
+	"tab":        "Tab",
+	"up":         "ArrowUp",
+}
+
+// NormalizeKey returns the name of a key as sent to and from the App.  A key is named by any
+// modifiers followed by the key itself, joined with a plus sign.  Modifiers are Ctrl, Alt,
+// Shift, and Meta, in that order.  The key itself is a single character, with letters in
+// upper case, a function key F1 to F24, or one of ArrowDown, ArrowLeft, ArrowRight, ArrowUp,
+// Backspace, Delete, End, Enter, Escape, Home, Insert, PageDown, PageUp, Space, or Tab.
+//
+// Names are not case sensitive and common alternatives, such as "Cmd" or "Esc", are accepted,
+// so "shift+ctrl+s" is normalized to "Ctrl+Shift+S".  Returns an error if the key can't be named.
+func NormalizeKey(k string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(k), "+")
+
+	// The plus key itself leaves an empty last part
+	if len(parts) > 1 && parts[len(parts)-1] == "" && parts[len(parts)-2] == "" {
+		parts = append(parts[:len(parts)-2], "+")
+	}
+
+	modifiers := map[string]bool{}
+	for _, part := range parts[:len(parts)-1] {
+		modifier, ok := keyModifierAliases[strings.ToLower(strings.TrimSpace(part))]
+		if !ok {
+			return "", fmt.Errorf("unknown modifier %q in key %q", part, k)
+		}
+		modifiers[modifier] = true
+	}
+
+	name, err := normalizeKeyName(parts[len(parts)-1])
+	if err != nil {
+		return "", fmt.Errorf("%w in key %q", err, k)
+	}
+
+	var b strings.Builder
+	for _, modifier := range keyModifiers {
+		if modifiers[modifier] {
+			b.WriteString(modifier)
+			b.WriteString("+")
+		}
+	}
+	b.WriteString(name)
+	return b.String(), nil
+}
+
+// Returns the name of a key without any modifiers.
+func normalizeKeyName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("missing key")
+	}
+	if utf8.RuneCountInString(name) == 1 {
+		return strings.ToUpper(name), nil
+	}
+
+	lower := strings.ToLower(name)
+	if n, ok := keyNames[lower]; ok {
+		return n, nil
+	}
+	if rest, ok := strings.CutPrefix(lower, "f"); ok {
+		if n, err := strconv.Atoi(rest); err == nil && n >= 1 && n <= 24 && strconv.Itoa(n) == rest {
+			return "F" + rest, nil
+		}
+	}
+	return "", fmt.Errorf("unknown key %q", name)
+}
+
+// Returns the name of a keyboard shortcut as normalized by NormalizeKey, or the name as given
+// if it can't be normalized, so the App can decide what to make of it.
+func shortcutName(s string) string {
+	if s == "" {
+		return ""
+	}
+	if normalized, err := NormalizeKey(s); err == nil {
+		return normalized
+	}
+	return s
+}
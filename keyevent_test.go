@@ -0,0 +1,102 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_KeyEventAttachedFields(t *testing.T) {
+	ke := &KeyEvent{}
+	ke.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, ke.PrimitiveBase, "Embodiment", "Key", "KeyPressed", "Keys", "Status", "Tag")
+}
+
+func Test_KeyEventMake(t *testing.T) {
+	ke := NewKeyEvent("ctrl+s", "esc")
+	if !slices.Equal(ke.Keys(), []string{"Ctrl+S", "Escape"}) {
+		t.Errorf("keys are %v.  Expecting them to be normalized", ke.Keys())
+	}
+	if len(NewKeyEvent().Keys()) != 0 {
+		t.Error("expecting no keys")
+	}
+}
+
+func Test_KeyEventMakeInvalidKey(t *testing.T) {
+	ke := NewKeyEvent("Hyper+S", "ctrl+s")
+	if !slices.Equal(ke.Keys(), []string{"Hyper+S", "Ctrl+S"}) {
+		t.Errorf("unexpected keys %v", ke.Keys())
+	}
+}
+
+func Test_KeyEventSetKeys(t *testing.T) {
+	ke := NewKeyEvent("esc")
+	if err := ke.SetKeysVA("f5", "alt+ctrl+x"); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(ke.Keys(), []string{"F5", "Ctrl+Alt+X"}) {
+		t.Errorf("unexpected keys %v", ke.Keys())
+	}
+	if err := ke.SetKeys([]string{"F1", "Hyper+S"}); err == nil {
+		t.Error("expecting an error for an invalid key")
+	}
+	if !slices.Equal(ke.Keys(), []string{"F5", "Ctrl+Alt+X"}) {
+		t.Errorf("keys changed after an invalid key: %v", ke.Keys())
+	}
+}
+
+func Test_KeyEventIngestUpdate(t *testing.T) {
+	ts := time.Now()
+	ke := NewKeyEvent()
+	ke.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if ke.Pressed() {
+		t.Error("key pressed before any update")
+	}
+	if err := ke.IngestUpdate(map[any]any{"Key": "shift+ctrl+a", "KeyPressed": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !ke.Pressed() || ke.Key() != "Ctrl+Shift+A" || ke.String() != "Ctrl+Shift+A" {
+		t.Errorf("unable to ingest key press.  Pressed is %v and key is %s", ke.Pressed(), ke.Key())
+	}
+}
+
+func Test_NormalizeKey(t *testing.T) {
+	tests := []struct {
+		keys string
+		want string
+	}{
+		{"Ctrl+S", "Ctrl+S"},
+		{"ctrl+s", "Ctrl+S"},
+		{"Shift+Control+s", "Ctrl+Shift+S"},
+		{"cmd+alt+z", "Alt+Meta+Z"},
+		{" Ctrl + F5 ", "Ctrl+F5"},
+		{"f12", "F12"},
+		{"Esc", "Escape"},
+		{"return", "Enter"},
+		{"Ctrl+up", "Ctrl+ArrowUp"},
+		{"Ctrl++", "Ctrl++"},
+		{"+", "+"},
+		{"1", "1"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeKey(tt.keys)
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizeKey(%q) = %q, %v.  Expecting %q", tt.keys, got, err, tt.want)
+		}
+	}
+
+	for _, keys := range []string{"", "Ctrl+", "Hyper+S", "F25", "F05", "Foo"} {
+		if _, err := NormalizeKey(keys); err == nil {
+			t.Errorf("NormalizeKey(%q) expecting an error", keys)
+		}
+	}
+}
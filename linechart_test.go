@@ -0,0 +1,256 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"slices"
+	"testing"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/prontogui/golib/key"
+)
+
+func Test_LineChartAttachedFields(t *testing.T) {
+	chart := &LineChart{}
+	chart.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, chart.PrimitiveBase, "Embodiment", "Legend", "LineSeries", "Status", "Tag", "Title", "XAxis", "YAxis")
+}
+
+func Test_BarChartAttachedFields(t *testing.T) {
+	chart := &BarChart{}
+	chart.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, chart.PrimitiveBase, "BarSeries", "Embodiment", "Legend", "Status", "Tag", "Title", "XAxis", "YAxis")
+}
+
+func Test_ScatterChartAttachedFields(t *testing.T) {
+	chart := &ScatterChart{}
+	chart.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, chart.PrimitiveBase, "Embodiment", "Legend", "ScatterSeries", "Status", "Tag", "Title", "XAxis", "YAxis")
+}
+
+func Test_AxisAttachedFields(t *testing.T) {
+	axis := &Axis{}
+	axis.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, axis.PrimitiveBase, "Categories", "Embodiment", "Label", "MaxValue", "MinValue", "Tag")
+}
+
+func Test_LineChartMake(t *testing.T) {
+	temp := NewChartSeries("Temperature", Point{0, 20}, Point{1, 21}, Point{2, 22})
+	xAxis := NewAxis("Time")
+	chart := LineChartWith{
+		Title:      "Oven",
+		LineSeries: []*ChartSeries{temp},
+		Legend:     true,
+		WindowSize: 2,
+		XAxis:      xAxis,
+	}.Make()
+
+	if chart.Title() != "Oven" || chart.String() != "Oven" {
+		t.Error("could not initialize Title field")
+	}
+	if series := chart.LineSeries(); len(series) != 1 || series[0] != temp {
+		t.Error("could not initialize LineSeries field")
+	}
+	if !chart.Legend() {
+		t.Error("could not initialize Legend field")
+	}
+	if chart.XAxis() != xAxis || chart.YAxis() != nil {
+		t.Error("could not initialize axes")
+	}
+	if temp.WindowSize() != 2 || len(temp.Points()) != 2 {
+		t.Error("window size was not applied to the series")
+	}
+}
+
+func Test_LineChartLocateNextDescendant(t *testing.T) {
+	temp := NewChartSeries("Temperature")
+	xAxis := NewAxis("Time")
+	yAxis := NewAxis("°C")
+	chart := LineChartWith{LineSeries: []*ChartSeries{temp}, XAxis: xAxis, YAxis: yAxis}.Make()
+
+	if chart.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 0))) != temp {
+		t.Error("unable to locate series")
+	}
+	if chart.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 1))) != nil {
+		t.Error("expecting nil for a series out of range")
+	}
+	if chart.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1))) != xAxis {
+		t.Error("unable to locate X axis")
+	}
+	if chart.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(2))) != yAxis {
+		t.Error("unable to locate Y axis")
+	}
+}
+
+func Test_LineChartAddPointSendsNewPoints(t *testing.T) {
+	chart := NewLineChart("Oven", NewChartSeries("Temperature"), NewChartSeries("Humidity", Point{-1, 39})).SetWindowSize(100)
+
+	s := NewSynchro()
+	s.SetPointsAppend(true)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), chart)
+	if _, err := s.GetFullUpdate(); err != nil {
+		t.Fatal(err)
+	}
+
+	chart.AddPoint(1, 0, 40)
+	chart.AddPoint(1, 1, 41)
+
+	updatesCbor, err := s.GetPartialUpdate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var updates []any
+	if err := cbor.Unmarshal(updatesCbor, &updates); err != nil {
+		t.Fatal(err)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("expecting one partial update; got %v", updates)
+	}
+	if pkey, _ := updates[1].([]any); !slices.Equal(pkey, []any{uint64(0), uint64(0), uint64(1)}) {
+		t.Errorf("expecting an update to the second series; got pkey %v", updates[1])
+	}
+	m, _ := updates[2].(map[any]any)
+	points, _ := m["Points"].(map[any]any)
+	if appended, _ := points[pointsAppend].([]any); len(appended) != 2 || points[pointsWindow] != uint64(100) {
+		t.Errorf("expecting the new points to be appended; got %v", m)
+	}
+}
+
+func Test_BarChartCategories(t *testing.T) {
+	chart := BarChartWith{
+		XAxis:     AxisWith{Categories: []string{"Jan", "Feb"}}.Make(),
+		BarSeries: []*ChartSeries{NewChartSeries("Sales", Point{0, 10}, Point{1, 12})},
+	}.Make()
+
+	if !slices.Equal(chart.XAxis().Categories(), []string{"Jan", "Feb"}) {
+		t.Error("could not initialize Categories field")
+	}
+	if len(chart.BarSeries()[0].Points()) != 2 {
+		t.Error("could not initialize BarSeries field")
+	}
+}
+
+func Test_LineChartAddPointWithSupportedFields(t *testing.T) {
+	series := NewChartSeries("Temperature", Point{0, 20})
+	chart := NewLineChart("Oven", series)
+
+	s := NewSynchro()
+	s.SetPointsAppend(true)
+	s.SetSupportedFields([]string{"LineSeries", "Points"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), chart)
+	getFullUpdateItems(t, s)
+
+	chart.AddPoint(0, 1, 21)
+
+	updates := getPartialUpdateItems(t, s)
+	m, _ := updates[2].(map[any]any)
+	points, _ := m["Points"].(map[any]any)
+	if appended, _ := points[pointsAppend].([]any); len(appended) != 1 {
+		t.Errorf("expecting the new point to be appended; got %v", m)
+	}
+}
+
+func Test_LineChartAddPointWithoutPointsAppend(t *testing.T) {
+	chart := NewLineChart("Oven", NewChartSeries("Temperature", Point{0, 20}))
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), chart)
+	getFullUpdateItems(t, s)
+
+	chart.AddPoint(0, 1, 21)
+
+	// All the points are sent to an App that can't append them
+	updates := getPartialUpdateItems(t, s)
+	m, _ := updates[2].(map[any]any)
+	if points, _ := m["Points"].([]any); len(points) != 2 {
+		t.Errorf("expecting all the points; got %v", m)
+	}
+}
+
+func Test_LineChartAddPointNoSuchSeries(t *testing.T) {
+	chart := NewLineChart("Oven", NewChartSeries("Temperature"))
+
+	if err := chart.AddPoint(1, 0, 20); err == nil {
+		t.Error("expecting an error for a series that doesn't exist")
+	}
+	if err := chart.AddPoint(-1, 0, 20); err == nil {
+		t.Error("expecting an error for a negative series")
+	}
+	if err := chart.AddPoint(0, 0, 20); err != nil || len(chart.LineSeries()[0].Points()) != 1 {
+		t.Errorf("unable to add a point: %v", err)
+	}
+}
+
+func Test_LineChartSetWindowSizeNegative(t *testing.T) {
+	series := NewChartSeries("Temperature", Point{0, 20}, Point{1, 21})
+	chart := NewLineChart("Oven", series).SetWindowSize(-5)
+
+	if chart.WindowSize() != 0 || series.WindowSize() != 0 || len(series.Points()) != 2 {
+		t.Errorf("window size is %d.  Expecting a negative size to be taken as 0", chart.WindowSize())
+	}
+}
+
+func Test_BarChartMake(t *testing.T) {
+	sales := NewChartSeries("Sales", Point{0, 10}, Point{1, 12}, Point{2, 9})
+	chart := BarChartWith{
+		Title:      "Sales",
+		BarSeries:  []*ChartSeries{sales},
+		WindowSize: 2,
+		YAxis:      NewAxis("Units"),
+	}.Make()
+
+	if chart.Title() != "Sales" || chart.String() != "Sales" {
+		t.Error("could not initialize Title field")
+	}
+	if series := chart.BarSeries(); len(series) != 1 || series[0] != sales {
+		t.Error("could not initialize BarSeries field")
+	}
+	if chart.XAxis() != nil || chart.YAxis().Label() != "Units" {
+		t.Error("could not initialize axes")
+	}
+	if len(sales.Points()) != 2 {
+		t.Error("window size was not applied to the series")
+	}
+
+	if err := chart.AddPoint(0, 3, 11); err != nil {
+		t.Fatal(err)
+	}
+	if points := sales.Points(); len(points) != 2 || points[1] != (Point{3, 11}) {
+		t.Errorf("points are %v.  Expecting the new point at the end of the window", points)
+	}
+	if chart.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 0))) != sales {
+		t.Error("unable to locate series")
+	}
+}
+
+func Test_ScatterChartMake(t *testing.T) {
+	heights := NewChartSeries("Heights", Point{150, 50}, Point{180, 80})
+	chart := ScatterChartWith{
+		Title:         "Height vs. weight",
+		ScatterSeries: []*ChartSeries{heights},
+		Legend:        true,
+		XAxis:         NewAxis("cm"),
+	}.Make()
+
+	if chart.Title() != "Height vs. weight" || !chart.Legend() {
+		t.Error("could not initialize Title and Legend fields")
+	}
+	if series := chart.ScatterSeries(); len(series) != 1 || series[0] != heights {
+		t.Error("could not initialize ScatterSeries field")
+	}
+
+	chart.SetScatterSeriesVA(heights, NewChartSeries("Others")).SetWindowSize(1)
+	if len(chart.ScatterSeries()) != 2 || len(heights.Points()) != 1 {
+		t.Error("could not set series with a window size")
+	}
+	if chart.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1))) != chart.XAxis() {
+		t.Error("unable to locate X axis")
+	}
+	if err := chart.AddPoint(2, 0, 0); err == nil {
+		t.Error("expecting an error for a series that doesn't exist")
+	}
+}
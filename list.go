@@ -90,14 +90,14 @@ func (list *List) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 	// Fields are handled in alphabetical order
 	switch nextIndex {
 	case 0:
-		return list.ListItems()[locator.NextIndex()]
+		return itemAt(list.ListItems(), locator.NextIndex())
 	case 1:
 		return list.ModelFolder()
 	case 2:
 		return list.ModelItem()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a JSON string specifying the embodiment to use for this primitive.
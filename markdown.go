@@ -0,0 +1,182 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A markdown primitive displays formatted text, such as a help screen or status panel.
+type MarkdownWith struct {
+	Embodiment string
+	Markdown   string
+	Status     int
+	Tag        string
+}
+
+// Creates a new Markdown primitive using the supplied field assignments.
+func (w MarkdownWith) Make() *Markdown {
+	md := &Markdown{}
+	md.embodiment.Set(w.Embodiment)
+	md.markdown.Set(w.Markdown)
+	md.status.Set(w.Status)
+	md.tag.Set(w.Tag)
+	return md
+}
+
+// A markdown primitive displays formatted text, such as a help screen or status panel.  The
+// text is written in Markdown (CommonMark), which allows for headings, bold and italic text,
+// inline code, lists, and links.
+//
+// Links are not followed by the App.  Instead, when the user clicks a link, LinkClicked returns
+// true and Link returns its URL or anchor, such as "https://example.com" or "#setup".
+type Markdown struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment  StringField
+	link        StringField
+	linkClicked EventField
+	markdown    StringField
+	status      IntegerField
+	tag         StringField
+}
+
+// Create a new Markdown and assign its text.
+func NewMarkdown(markdown string) *Markdown {
+	return MarkdownWith{Markdown: markdown}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (md *Markdown) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	md.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &md.embodiment},
+			{key.FKey_Link, &md.link},
+			{key.FKey_LinkClicked, &md.linkClicked},
+			{key.FKey_Markdown, &md.markdown},
+			{key.FKey_Status, &md.status},
+			{key.FKey_Tag, &md.tag},
+		}
+	})
+}
+
+// Returns a string representation of this primitive:  the markdown text.
+// Implements of fmt:Stringer interface.
+func (md *Markdown) String() string {
+	return md.markdown.Get()
+}
+
+// Returns the markdown text to display.
+func (md *Markdown) Markdown() string {
+	return md.markdown.Get()
+}
+
+// Sets the markdown text to display.
+func (md *Markdown) SetMarkdown(s string) *Markdown {
+	md.markdown.Set(s)
+	return md
+}
+
+// Returns true if the user clicked a link during the current Wait cycle.
+func (md *Markdown) LinkClicked() bool {
+	return md.linkClicked.Issued()
+}
+
+// Returns the URL or anchor of the link last clicked by the user.
+func (md *Markdown) Link() string {
+	return md.link.Get()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (md *Markdown) Embodiment() string {
+	return md.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (md *Markdown) SetEmbodiment(s string) *Markdown {
+	md.embodiment.Set(s)
+	return md
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (md *Markdown) Tag() string {
+	return md.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (md *Markdown) SetTag(s string) *Markdown {
+	md.tag.Set(s)
+	return md
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Markdown) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Markdown) SetStatus(i int) *Markdown {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Markdown) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Markdown) SetVisible(visible bool) *Markdown {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Markdown) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Markdown) SetEnabled(enabled bool) *Markdown {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Markdown) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Markdown) SetCollapsed(collapsed bool) *Markdown {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
@@ -0,0 +1,47 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_MarkdownAttachedFields(t *testing.T) {
+	md := &Markdown{}
+	md.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, md.PrimitiveBase, "Embodiment", "Link", "LinkClicked", "Markdown", "Status", "Tag")
+}
+
+func Test_MarkdownMake(t *testing.T) {
+	md := MarkdownWith{Markdown: "# Help\nSee [setup](#setup).", Embodiment: "card", Tag: "help"}.Make()
+
+	if md.Markdown() != "# Help\nSee [setup](#setup)." || md.String() != md.Markdown() {
+		t.Error("could not initialize Markdown field")
+	}
+	if md.Embodiment() != "card" || md.Tag() != "help" {
+		t.Error("could not initialize fields")
+	}
+}
+
+func Test_MarkdownIngestLinkClicked(t *testing.T) {
+	ts := time.Now()
+	md := NewMarkdown("Visit [us](https://example.com).")
+	md.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if md.LinkClicked() {
+		t.Error("link clicked before any update")
+	}
+	if err := md.IngestUpdate(map[any]any{"Link": "https://example.com", "LinkClicked": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !md.LinkClicked() || md.Link() != "https://example.com" {
+		t.Errorf("unable to ingest link click.  Link is %q", md.Link())
+	}
+}
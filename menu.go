@@ -0,0 +1,240 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A menu of MenuItems and Submenus, used either as the menu bar of the window or as the
+// context menu of another primitive.
+type MenuWith struct {
+	Embodiment string
+	MenuItems  []Primitive
+	MenuTarget Primitive
+	Status     int
+	Tag        string
+}
+
+// Makes a new Menu with specified field values.
+func (w MenuWith) Make() *Menu {
+	menu := &Menu{}
+	menu.embodiment.Set(w.Embodiment)
+	menu.menuItems.Set(w.MenuItems)
+	menu.menuTarget.Set(w.MenuTarget)
+	menu.status.Set(w.Status)
+	menu.tag.Set(w.Tag)
+	return menu
+}
+
+// A menu of MenuItems and Submenus.  It is used in one of two ways:
+//
+// A menu without a target is the menu bar of the window.  Put it among the top-level primitives
+// given to SetGUI.
+//
+// A menu with a target is a context menu.  The target is shown in place of the menu, and the
+// menu opens when the user right-clicks or long-presses the target.  To have a context menu
+// for each row of a Table, make a context menu the target of which is a cell of the row.  The
+// row of an issued item can then be found using IndexOf.
+type Menu struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment StringField
+	menuItems  Any1DField
+	menuTarget AnyField
+	status     IntegerField
+	tag        StringField
+}
+
+// Creates a new menu bar for the window with the given MenuItems and Submenus.
+func NewMenu(items ...Primitive) *Menu {
+	return MenuWith{MenuItems: items}.Make()
+}
+
+// Creates a new context menu for a target primitive with the given MenuItems and Submenus.
+func NewContextMenu(target Primitive, items ...Primitive) *Menu {
+	return MenuWith{MenuItems: items, MenuTarget: target}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (menu *Menu) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	menu.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &menu.embodiment},
+			{key.FKey_MenuItems, &menu.menuItems},
+			{key.FKey_MenuTarget, &menu.menuTarget},
+			{key.FKey_Status, &menu.status},
+			{key.FKey_Tag, &menu.tag},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (menu *Menu) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	nextIndex := locator.NextIndex()
+
+	switch nextIndex {
+	case 0:
+		return itemAt(menu.MenuItems(), locator.NextIndex())
+	case 1:
+		return menu.MenuTarget()
+	}
+
+	return nil
+}
+
+// Returns a string representation of this primitive:  the target of a context menu, or
+// empty for a menu bar.  Implements of fmt:Stringer interface.
+func (menu *Menu) String() string {
+	if target := menu.MenuTarget(); target != nil {
+		return target.String()
+	}
+	return ""
+}
+
+// Returns the menu item within this menu, or any of its submenus, that was issued during
+// the current Wait cycle.  Returns nil if none was issued.
+func (menu *Menu) IssuedItem() *MenuItem {
+	return issuedMenuItem(menu.MenuItems())
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (menu *Menu) Embodiment() string {
+	return menu.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (menu *Menu) SetEmbodiment(s string) *Menu {
+	menu.embodiment.Set(s)
+	return menu
+}
+
+// Returns the items of the menu, which are MenuItems and Submenus.
+func (menu *Menu) MenuItems() []Primitive {
+	return menu.menuItems.Get()
+}
+
+// Sets the items of the menu, which are MenuItems and Submenus.
+func (menu *Menu) SetMenuItems(items []Primitive) *Menu {
+	menu.menuItems.Set(items)
+	return menu
+}
+
+// Sets the items (variadic argument list) of the menu, which are MenuItems and Submenus.
+func (menu *Menu) SetMenuItemsVA(items ...Primitive) *Menu {
+	menu.menuItems.Set(items)
+	return menu
+}
+
+// Returns the primitive this is a context menu for, or nil if this is a menu bar.
+func (menu *Menu) MenuTarget() Primitive {
+	return menu.menuTarget.Get()
+}
+
+// Sets the primitive this is a context menu for.  Setting nil makes this a menu bar.
+func (menu *Menu) SetMenuTarget(p Primitive) *Menu {
+	menu.menuTarget.Set(p)
+	return menu
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (menu *Menu) Tag() string {
+	return menu.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (menu *Menu) SetTag(s string) *Menu {
+	menu.tag.Set(s)
+	return menu
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Menu) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Menu) SetStatus(i int) *Menu {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Menu) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Menu) SetVisible(visible bool) *Menu {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Menu) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Menu) SetEnabled(enabled bool) *Menu {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Menu) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Menu) SetCollapsed(collapsed bool) *Menu {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
+
+// Returns the first MenuItem among items, or within nested Submenus, that was issued during
+// the current Wait cycle.  Returns nil if none was issued.
+func issuedMenuItem(items []Primitive) *MenuItem {
+	for _, item := range items {
+		switch item := item.(type) {
+		case *MenuItem:
+			if item.Issued() {
+				return item
+			}
+		case *Submenu:
+			if issued := item.IssuedItem(); issued != nil {
+				return issued
+			}
+		}
+	}
+	return nil
+}
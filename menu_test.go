@@ -0,0 +1,111 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_MenuAttachedFields(t *testing.T) {
+	menu := &Menu{}
+	menu.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, menu.PrimitiveBase, "Embodiment", "MenuItems", "MenuTarget", "Status", "Tag")
+}
+
+func Test_MenuItemAttachedFields(t *testing.T) {
+	item := &MenuItem{}
+	item.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, item.PrimitiveBase, "Checked", "Embodiment", "Icon", "Label", "MenuItemIssued", "Shortcut", "Status", "Tag")
+}
+
+func Test_SubmenuAttachedFields(t *testing.T) {
+	submenu := &Submenu{}
+	submenu.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, submenu.PrimitiveBase, "Embodiment", "Icon", "Label", "Status", "SubmenuItems", "Tag")
+}
+
+func Test_MenuItemMake(t *testing.T) {
+	item := MenuItemWith{Label: "Save", Shortcut: "Ctrl+S", Checked: true, Icon: NewIcon("save"), Status: 1}.Make()
+
+	if item.Label() != "Save" || item.String() != "Save" {
+		t.Error("could not initialize Label field")
+	}
+	if item.Shortcut() != "Ctrl+S" {
+		t.Error("could not initialize Shortcut field")
+	}
+	if !item.Checked() {
+		t.Error("could not initialize Checked field")
+	}
+	if item.Icon() == nil {
+		t.Error("could not initialize Icon field")
+	}
+	if item.Enabled() {
+		t.Error("could not initialize Status field")
+	}
+}
+
+func Test_MenuMake(t *testing.T) {
+	save := NewMenuItem("Save")
+	menu := NewMenu(NewSubmenu("File", save))
+	if menu.MenuTarget() != nil || menu.String() != "" {
+		t.Error("expecting a menu bar without a target")
+	}
+	if len(menu.MenuItems()) != 1 {
+		t.Error("could not initialize MenuItems field")
+	}
+
+	target := NewText("row 1")
+	context := NewContextMenu(target, NewMenuItem("Delete"))
+	if context.MenuTarget() != target || context.String() != "row 1" {
+		t.Error("could not initialize MenuTarget field")
+	}
+}
+
+func Test_MenuLocateNextDescendant(t *testing.T) {
+	icon := NewIcon("save")
+	save := MenuItemWith{Label: "Save", Icon: icon}.Make()
+	file := NewSubmenu("File", save)
+	target := NewText("row 1")
+	menu := MenuWith{MenuItems: []Primitive{file}, MenuTarget: target}.Make()
+
+	if menu.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 0))) != file {
+		t.Error("unable to locate submenu")
+	}
+	if menu.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1))) != target {
+		t.Error("unable to locate target")
+	}
+	if menu.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 1))) != nil {
+		t.Error("expecting nil for an item out of range")
+	}
+	if file.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1, 0))) != save {
+		t.Error("unable to locate submenu item")
+	}
+	if save.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0))) != icon {
+		t.Error("unable to locate icon")
+	}
+}
+
+func Test_MenuIssuedItem(t *testing.T) {
+	ts := time.Now()
+	open := NewMenuItem("Open")
+	save := NewMenuItem("Save")
+	menu := NewMenu(NewSubmenu("File", open, NewSubmenu("More", save)))
+	menu.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if menu.IssuedItem() != nil {
+		t.Error("expecting no issued item before any update")
+	}
+	if err := save.IngestUpdate(map[any]any{"MenuItemIssued": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !save.Issued() || menu.IssuedItem() != save {
+		t.Error("unable to find the issued item")
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// An item of a Menu or Submenu that is issued when the user chooses it, like a Command.
+type MenuItemWith struct {
+	Checked    bool
+	Embodiment string
+	Icon       Primitive
+	Label      string
+	Shortcut   string
+	Status     int
+	Tag        string
+}
+
+// Makes a new MenuItem with specified field values.
+func (w MenuItemWith) Make() *MenuItem {
+	item := &MenuItem{}
+	item.checked.Set(w.Checked)
+	item.embodiment.Set(w.Embodiment)
+	item.icon.Set(w.Icon)
+	item.label.Set(w.Label)
+	item.SetShortcut(w.Shortcut)
+	item.status.Set(w.Status)
+	item.tag.Set(w.Tag)
+	return item
+}
+
+// An item of a Menu or Submenu that is issued when the user chooses it, like a Command.  It
+// has a label, an optional icon, and an optional keyboard shortcut shown alongside it.  A
+// check mark is shown next to the item when it is checked.  Use SetEnabled to disable it.
+type MenuItem struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	checked        BooleanField
+	embodiment     StringField
+	icon           AnyField
+	label          StringField
+	menuItemIssued EventField
+	shortcut       StringField
+	status         IntegerField
+	tag            StringField
+}
+
+// Creates a new menu item and assigns a label.
+func NewMenuItem(label string) *MenuItem {
+	return MenuItemWith{Label: label}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (item *MenuItem) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	item.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Checked, &item.checked},
+			{key.FKey_Embodiment, &item.embodiment},
+			{key.FKey_Icon, &item.icon},
+			{key.FKey_Label, &item.label},
+			{key.FKey_MenuItemIssued, &item.menuItemIssued},
+			{key.FKey_Shortcut, &item.shortcut},
+			{key.FKey_Status, &item.status},
+			{key.FKey_Tag, &item.tag},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (item *MenuItem) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	nextIndex := locator.NextIndex()
+
+	switch nextIndex {
+	case 0:
+		return item.Icon()
+	}
+
+	return nil
+}
+
+// Returns a string representation of this primitive:  the label.
+// Implements of fmt:Stringer interface.
+func (item *MenuItem) String() string {
+	return item.label.Get()
+}
+
+// Returns true if the menu item was issued during the current Wait cycle.
+func (item *MenuItem) Issued() bool {
+	return item.menuItemIssued.Issued()
+}
+
+// Returns true if a check mark is shown next to the menu item.
+func (item *MenuItem) Checked() bool {
+	return item.checked.Get()
+}
+
+// Sets whether a check mark is shown next to the menu item.
+func (item *MenuItem) SetChecked(checked bool) *MenuItem {
+	item.checked.Set(checked)
+	return item
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (item *MenuItem) Embodiment() string {
+	return item.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (item *MenuItem) SetEmbodiment(s string) *MenuItem {
+	item.embodiment.Set(s)
+	return item
+}
+
+// Returns the optional icon shown next to the label.
+func (item *MenuItem) Icon() Primitive {
+	return item.icon.Get()
+}
+
+// Sets the optional icon shown next to the label.
+func (item *MenuItem) SetIcon(p Primitive) *MenuItem {
+	item.icon.Set(p)
+	return item
+}
+
+// Returns the label to display in the menu item.
+func (item *MenuItem) Label() string {
+	return item.label.Get()
+}
+
+// Sets the label to display in the menu item.
+func (item *MenuItem) SetLabel(s string) *MenuItem {
+	item.label.Set(s)
+	return item
+}
+
+// Returns the keyboard shortcut shown alongside the label, such as "Ctrl+S".
+func (item *MenuItem) Shortcut() string {
+	return item.shortcut.Get()
+}
+
+// Sets the keyboard shortcut shown alongside the label, such as "Ctrl+S".  The App issues the
+// menu item when the shortcut is pressed.  See NormalizeKey for how keys are named.
+func (item *MenuItem) SetShortcut(s string) *MenuItem {
+	item.shortcut.Set(shortcutName(s))
+	return item
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (item *MenuItem) Tag() string {
+	return item.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (item *MenuItem) SetTag(s string) *MenuItem {
+	item.tag.Set(s)
+	return item
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *MenuItem) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *MenuItem) SetStatus(i int) *MenuItem {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *MenuItem) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *MenuItem) SetVisible(visible bool) *MenuItem {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *MenuItem) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *MenuItem) SetEnabled(enabled bool) *MenuItem {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *MenuItem) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *MenuItem) SetCollapsed(collapsed bool) *MenuItem {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
@@ -0,0 +1,222 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// The level of a notification, which determines how it is shown.
+type NotificationLevel int
+
+const (
+	NotifyInfo NotificationLevel = iota
+	NotifySuccess
+	NotifyWarning
+	NotifyError
+)
+
+// How long a notification is shown for if no duration is given.
+const DefaultNotificationDuration = 4 * time.Second
+
+// A transient message shown to the user, such as a toast or snackbar.
+type NotificationWith struct {
+	Duration   time.Duration
+	Embodiment string
+	Level      NotificationLevel
+	Message    string
+	Tag        string
+}
+
+// Creates a new Notification using the supplied field assignments.
+func (w NotificationWith) Make() *Notification {
+	notification := &Notification{}
+	notification.SetDuration(w.Duration)
+	notification.embodiment.Set(w.Embodiment)
+	notification.level.Set(int(w.Level))
+	notification.message.Set(w.Message)
+	notification.tag.Set(w.Tag)
+	return notification
+}
+
+// A transient message shown to the user, such as a toast or snackbar.  Notifications are
+// normally shown using Session.Notify.
+type Notification struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	durationMs IntegerField
+	embodiment StringField
+	level      IntegerField
+	message    StringField
+	tag        StringField
+
+	// When the notification is no longer shown.
+	expires time.Time
+}
+
+// Creates a new Notification with a message and level, shown for the default duration.
+func NewNotification(message string, level NotificationLevel) *Notification {
+	return NotificationWith{Message: message, Level: level}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (notification *Notification) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	notification.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_DurationMs, &notification.durationMs},
+			{key.FKey_Embodiment, &notification.embodiment},
+			{key.FKey_Level, &notification.level},
+			{key.FKey_Message, &notification.message},
+			{key.FKey_Tag, &notification.tag},
+		}
+	})
+}
+
+// Returns a string representation of this primitive:  the message.
+// Implements of fmt:Stringer interface.
+func (notification *Notification) String() string {
+	return notification.message.Get()
+}
+
+// Returns the message shown to the user.
+func (notification *Notification) Message() string {
+	return notification.message.Get()
+}
+
+// Sets the message shown to the user.
+func (notification *Notification) SetMessage(s string) *Notification {
+	notification.message.Set(s)
+	return notification
+}
+
+// Returns the level of the notification, which determines how it is shown.
+func (notification *Notification) Level() NotificationLevel {
+	return NotificationLevel(notification.level.Get())
+}
+
+// Sets the level of the notification, which determines how it is shown.
+func (notification *Notification) SetLevel(level NotificationLevel) *Notification {
+	notification.level.Set(int(level))
+	return notification
+}
+
+// Returns how long the notification is shown for.
+func (notification *Notification) Duration() time.Duration {
+	return time.Duration(notification.durationMs.Get()) * time.Millisecond
+}
+
+// Sets how long the notification is shown for, to the millisecond.  A duration of 0 or less
+// uses DefaultNotificationDuration.
+func (notification *Notification) SetDuration(d time.Duration) *Notification {
+	if d <= 0 {
+		d = DefaultNotificationDuration
+	}
+	notification.durationMs.Set(int(d / time.Millisecond))
+	return notification
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (notification *Notification) Embodiment() string {
+	return notification.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (notification *Notification) SetEmbodiment(s string) *Notification {
+	notification.embodiment.Set(s)
+	return notification
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (notification *Notification) Tag() string {
+	return notification.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (notification *Notification) SetTag(s string) *Notification {
+	notification.tag.Set(s)
+	return notification
+}
+
+// The place on top of the GUI where notifications are shown.  Each session has one, which is
+// added by Session.Notify when first needed.
+type NotificationArea struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment        StringField
+	notificationItems Any1DField
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (area *NotificationArea) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	area.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &area.embodiment},
+			{key.FKey_NotificationItems, &area.notificationItems},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (area *NotificationArea) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	if locator.NextIndex() != 0 {
+		return nil
+	}
+	return itemAt(area.notificationItems.Get(), locator.NextIndex())
+}
+
+// Returns the notifications currently shown.
+func (area *NotificationArea) NotificationItems() []Primitive {
+	return area.notificationItems.Get()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (area *NotificationArea) Embodiment() string {
+	return area.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (area *NotificationArea) SetEmbodiment(s string) *NotificationArea {
+	area.embodiment.Set(s)
+	return area
+}
+
+// Adds a notification to those shown, dropping any that have expired as of now.
+func (area *NotificationArea) add(notification *Notification, now time.Time) {
+	notification.expires = now.Add(notification.Duration())
+	area.notificationItems.Set(append(area.unexpired(now), notification))
+}
+
+// Drops the notifications that have expired as of now, if any.
+func (area *NotificationArea) prune(now time.Time) {
+	if items := area.unexpired(now); len(items) != len(area.notificationItems.Get()) {
+		area.notificationItems.Set(items)
+	}
+}
+
+// Returns the notifications shown that haven't expired as of now.
+func (area *NotificationArea) unexpired(now time.Time) []Primitive {
+	items := []Primitive{}
+	for _, item := range area.notificationItems.Get() {
+		if n, ok := item.(*Notification); ok && now.Before(n.expires) {
+			items = append(items, n)
+		}
+	}
+	return items
+}
@@ -0,0 +1,89 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_NotificationAttachedFields(t *testing.T) {
+	notification := &Notification{}
+	notification.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, notification.PrimitiveBase, "DurationMs", "Embodiment", "Level", "Message", "Tag")
+}
+
+func Test_NotificationMake(t *testing.T) {
+	notification := NotificationWith{Message: "Saved", Level: NotifySuccess, Duration: 1500 * time.Millisecond}.Make()
+
+	if notification.Message() != "Saved" || notification.String() != "Saved" {
+		t.Error("could not initialize Message field")
+	}
+	if notification.Level() != NotifySuccess {
+		t.Error("could not initialize Level field")
+	}
+	if notification.Duration() != 1500*time.Millisecond {
+		t.Errorf("duration is %v.  Expecting 1.5s", notification.Duration())
+	}
+
+	if NewNotification("Saved", NotifyInfo).Duration() != DefaultNotificationDuration {
+		t.Error("expecting the default duration when none is given")
+	}
+}
+
+func Test_NotificationAreaAttachedFields(t *testing.T) {
+	area := &NotificationArea{}
+	area.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, area.PrimitiveBase, "Embodiment", "NotificationItems")
+}
+
+func Test_NotificationAreaDropsExpired(t *testing.T) {
+	area := &NotificationArea{}
+	now := time.Now()
+
+	first := NotificationWith{Message: "first", Duration: time.Second}.Make()
+	second := NotificationWith{Message: "second", Duration: 5 * time.Second}.Make()
+	third := NewNotification("third", NotifyError)
+
+	area.add(first, now)
+	area.add(second, now)
+	area.add(third, now.Add(2*time.Second))
+
+	items := area.NotificationItems()
+	if len(items) != 2 || items[0] != second || items[1] != third {
+		t.Fatalf("notifications are %v.  Expecting [second third]", items)
+	}
+
+	if area.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 1))) != third {
+		t.Error("unable to locate notification")
+	}
+}
+
+func Test_NotificationAreaPrune(t *testing.T) {
+	area := &NotificationArea{}
+	now := time.Now()
+
+	first := NotificationWith{Message: "first", Duration: time.Second}.Make()
+	second := NotificationWith{Message: "second", Duration: 5 * time.Second}.Make()
+	area.add(first, now)
+	area.add(second, now)
+
+	updates := 0
+	area.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { updates++ }, getBogeyEventTimestampProvider())
+
+	area.prune(now)
+	if len(area.NotificationItems()) != 2 || updates != 0 {
+		t.Fatal("notifications were changed before any expired")
+	}
+
+	area.prune(now.Add(2 * time.Second))
+	if items := area.NotificationItems(); len(items) != 1 || items[0] != second || updates != 1 {
+		t.Fatalf("notifications are %v.  Expecting [second]", items)
+	}
+}
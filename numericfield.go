@@ -7,24 +7,47 @@
 package golib
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
 	"github.com/prontogui/golib/key"
 )
 
-// A field for entering numeric values.
+// The number of decimal places given to SetDecimalPlaces to allow any number of digits after
+// the decimal point.
+const AnyDecimalPlaces = -1
+
+// A field for entering numeric values.  DecimalPlaces is the most digits allowed after the
+// decimal point, where 0 allows only whole numbers and AnyDecimalPlaces allows any number.
 type NumericFieldWith struct {
-	Embodiment   string
-	NumericEntry string
-	Status       int
-	Tag          string
+	DecimalPlaces int
+	Embodiment    string
+	ErrorMessage  string
+	MaxValue      float64
+	MinValue      float64
+	NumericEntry  string
+	Status        int
+	Step          float64
+	Tag           string
+	Validator     Validator
 }
 
 // Creates a new NumericField primitive using the supplied field assignments.
 func (w NumericFieldWith) Make() *NumericField {
 	nf := &NumericField{}
+	nf.decimalPlaces.Set(w.DecimalPlaces)
 	nf.embodiment.Set(w.Embodiment)
+	nf.errorMessage.Set(w.ErrorMessage)
+	nf.maxValue.Set(w.MaxValue)
+	nf.minValue.Set(w.MinValue)
 	nf.numericEntry.Set(w.NumericEntry)
 	nf.status.Set(w.Status)
+	nf.step.Set(w.Step)
 	nf.tag.Set(w.Tag)
+	nf.validator = w.Validator
 	return nf
 }
 
@@ -33,15 +56,24 @@ type NumericField struct {
 	// Mix-in the common guts for primitives
 	PrimitiveBase
 
-	embodiment   StringField
-	numericEntry StringField
-	status       IntegerField
-	tag          StringField
+	decimalPlaces IntegerField
+	embodiment    StringField
+	errorMessage  StringField
+	maxValue      FloatField
+	minValue      FloatField
+	numericEntry  StringField
+	status        IntegerField
+	step          FloatField
+	tag           StringField
+
+	// Checks the numeric entry made by the user.
+	validator Validator
 }
 
-// Create a new NumericField and assign its numeric entry field.
+// Create a new NumericField and assign its numeric entry field.  Any number of decimal places
+// is allowed.
 func NewNumericField(numericEntry string) *NumericField {
-	return NumericFieldWith{NumericEntry: numericEntry}.Make()
+	return NumericFieldWith{NumericEntry: numericEntry, DecimalPlaces: AnyDecimalPlaces}.Make()
 }
 
 // Prepares the primitive for tracking pending updates to send to the app and
@@ -51,14 +83,31 @@ func (nf *NumericField) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction
 
 	nf.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
 		return []FieldRef{
+			{key.FKey_DecimalPlaces, &nf.decimalPlaces},
 			{key.FKey_Embodiment, &nf.embodiment},
+			{key.FKey_ErrorMessage, &nf.errorMessage},
+			{key.FKey_MaxValue, &nf.maxValue},
+			{key.FKey_MinValue, &nf.minValue},
 			{key.FKey_NumericEntry, &nf.numericEntry},
 			{key.FKey_Status, &nf.status},
+			{key.FKey_Step, &nf.step},
 			{key.FKey_Tag, &nf.tag},
 		}
 	})
 }
 
+// Ingests an update from the app and validates the numeric entry made by the user.  A numeric
+// entry that is not a number, or is outside the bounds set for this field, is kept as entered
+// and the problem is shown in the ErrorMessage field.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (nf *NumericField) IngestUpdate(update map[any]any) error {
+	if err := nf.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	nf.Validate()
+	return nil
+}
+
 // Returns a string representation of this primitive:  the numeric entry.
 // Implements of fmt:Stringer interface.
 func (nf *NumericField) String() string {
@@ -76,6 +125,174 @@ func (nf *NumericField) SetNumericEntry(s string) *NumericField {
 	return nf
 }
 
+// Returns the numeric entry as a float64.  Returns an error if the entry is empty or is not
+// a number.
+func (nf *NumericField) Float64() (float64, error) {
+	s := strings.TrimSpace(nf.numericEntry.Get())
+	if s == "" {
+		return 0, errors.New("numeric entry is empty")
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// Sets the numeric entry to a float64 value with the given number of digits after the
+// decimal point.  A negative precision uses the fewest digits needed to represent the value.
+func (nf *NumericField) SetFloat64(v float64, precision int) *NumericField {
+	nf.numericEntry.Set(strconv.FormatFloat(v, 'f', precision, 64))
+	return nf
+}
+
+// Returns the numeric entry as an int.  Returns an error if the entry is empty or is not a
+// whole number.
+func (nf *NumericField) Int() (int, error) {
+	s := strings.TrimSpace(nf.numericEntry.Get())
+	if s == "" {
+		return 0, errors.New("numeric entry is empty")
+	}
+	if i, err := strconv.Atoi(s); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || f < math.MinInt || f > math.MaxInt {
+		return 0, fmt.Errorf("numeric entry %q is not a whole number", s)
+	}
+	return int(f), nil
+}
+
+// Sets the numeric entry to an int value.
+func (nf *NumericField) SetInt(i int) *NumericField {
+	nf.numericEntry.Set(strconv.Itoa(i))
+	return nf
+}
+
+// Returns the smallest value allowed.  The bounds only apply if MaxValue is greater than
+// MinValue.
+func (nf *NumericField) MinValue() float64 {
+	return nf.minValue.Get()
+}
+
+// Sets the smallest value allowed.  The bounds only apply if MaxValue is greater than
+// MinValue.
+func (nf *NumericField) SetMinValue(v float64) *NumericField {
+	nf.minValue.Set(v)
+	return nf
+}
+
+// Returns the largest value allowed.  The bounds only apply if MaxValue is greater than
+// MinValue.
+func (nf *NumericField) MaxValue() float64 {
+	return nf.maxValue.Get()
+}
+
+// Sets the largest value allowed.  The bounds only apply if MaxValue is greater than
+// MinValue.
+func (nf *NumericField) SetMaxValue(v float64) *NumericField {
+	nf.maxValue.Set(v)
+	return nf
+}
+
+// Returns the amount the value is changed by when stepped up or down in the App, or 0 if it
+// can't be stepped.
+func (nf *NumericField) Step() float64 {
+	return nf.step.Get()
+}
+
+// Sets the amount the value is changed by when stepped up or down in the App, or 0 if it
+// can't be stepped.
+func (nf *NumericField) SetStep(v float64) *NumericField {
+	nf.step.Set(v)
+	return nf
+}
+
+// Returns the most digits allowed after the decimal point.  0 means only whole numbers are
+// allowed and a negative number, such as AnyDecimalPlaces, means there is no limit.
+func (nf *NumericField) DecimalPlaces() int {
+	return nf.decimalPlaces.Get()
+}
+
+// Sets the most digits allowed after the decimal point, the same as the precision given to
+// SetFloat64.  0 allows only whole numbers and AnyDecimalPlaces allows any number of digits.
+func (nf *NumericField) SetDecimalPlaces(i int) *NumericField {
+	nf.decimalPlaces.Set(i)
+	return nf
+}
+
+// Checks that a numeric entry from the App is a number within the bounds and decimal places
+// set for this field.  An empty entry is allowed.
+func (nf *NumericField) checkEntry(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("numeric entry %q is not a number", s)
+	}
+
+	if min, max := nf.minValue.Get(), nf.maxValue.Get(); max > min && (v < min || v > max) {
+		return fmt.Errorf("numeric entry %s is not from %v to %v", s, min, max)
+	}
+
+	if places := nf.decimalPlaces.Get(); places >= 0 {
+		decimals := 0
+		if i := strings.IndexByte(s, '.'); i >= 0 {
+			decimals = len(strings.TrimRight(s[i+1:], "0"))
+		}
+		if decimals > 0 && places == 0 {
+			return fmt.Errorf("numeric entry %s is not a whole number", s)
+		}
+		if decimals > places {
+			return fmt.Errorf("numeric entry %s has more than %d decimal places", s, places)
+		}
+	}
+
+	return nil
+}
+
+// Returns the validator that checks the numeric entry, or nil if there is none.
+func (nf *NumericField) Validator() Validator {
+	return nf.validator
+}
+
+// Sets the validator that checks the numeric entry, such as NumericRange.  The entry is
+// validated each time the user changes it, after checking it against the bounds and decimal
+// places set for this field, and any error is shown in the ErrorMessage field.
+func (nf *NumericField) SetValidator(v Validator) *NumericField {
+	nf.validator = v
+	return nf
+}
+
+// Validates the numeric entry and shows any error in the ErrorMessage field.  Returns an error
+// if the entry is not a number, is outside the bounds or decimal places set for this field, or
+// is rejected by the validator.  Returns nil if the entry is valid.
+func (nf *NumericField) Validate() error {
+	return validateEntry(nf.validateEntry, nf.numericEntry.Get(), &nf.errorMessage)
+}
+
+// Checks a numeric entry, then validates it with the validator if there is one.
+func (nf *NumericField) validateEntry(s string) error {
+	if err := nf.checkEntry(s); err != nil {
+		return err
+	}
+	if nf.validator != nil {
+		return nf.validator(s)
+	}
+	return nil
+}
+
+// Returns the error message shown to the user, or empty if there is none.
+func (nf *NumericField) ErrorMessage() string {
+	return nf.errorMessage.Get()
+}
+
+// Sets the error message shown to the user.  This is normally set by Validate but can be
+// set directly, such as for errors found by other means.
+func (nf *NumericField) SetErrorMessage(s string) *NumericField {
+	nf.errorMessage.Set(s)
+	return nf
+}
+
 // Returns a JSON string specifying the embodiment to use for this primitive.
 func (nf *NumericField) Embodiment() string {
 	return nf.embodiment.Get()
@@ -0,0 +1,154 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_NumericFieldAttach(t *testing.T) {
+	nf := &NumericField{}
+	nf.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, nf.PrimitiveBase, "DecimalPlaces", "Embodiment", "ErrorMessage", "MaxValue", "MinValue", "NumericEntry", "Status", "Step", "Tag")
+}
+
+func Test_NumericFieldMake(t *testing.T) {
+	nf := NumericFieldWith{
+		DecimalPlaces: 2,
+		MaxValue:      100,
+		MinValue:      -100,
+		NumericEntry:  "12.5",
+		Step:          0.5,
+	}.Make()
+
+	if nf.DecimalPlaces() != 2 {
+		t.Error("could not initialize DecimalPlaces field")
+	}
+	if nf.MaxValue() != 100 || nf.MinValue() != -100 {
+		t.Error("could not initialize MaxValue and MinValue fields")
+	}
+	if nf.NumericEntry() != "12.5" {
+		t.Error("could not initialize NumericEntry field")
+	}
+	if nf.Step() != 0.5 {
+		t.Error("could not initialize Step field")
+	}
+}
+
+func Test_NumericFieldFloat64(t *testing.T) {
+	nf := NewNumericField(" 3.75 ")
+
+	v, err := nf.Float64()
+	if err != nil || v != 3.75 {
+		t.Errorf("Float64 returned %v, %v.  Expecting 3.75", v, err)
+	}
+
+	nf.SetFloat64(2.0/3.0, 3)
+	if nf.NumericEntry() != "0.667" {
+		t.Errorf("numeric entry is %q.  Expecting 0.667", nf.NumericEntry())
+	}
+
+	nf.SetFloat64(1.25, -1)
+	if nf.NumericEntry() != "1.25" {
+		t.Errorf("numeric entry is %q.  Expecting 1.25", nf.NumericEntry())
+	}
+
+	if _, err := nf.SetNumericEntry("abc").Float64(); err == nil {
+		t.Error("expecting an error for an entry that is not a number")
+	}
+	if _, err := nf.SetNumericEntry("").Float64(); err == nil {
+		t.Error("expecting an error for an empty entry")
+	}
+}
+
+func Test_NumericFieldInt(t *testing.T) {
+	nf := NewNumericField("").SetInt(-42)
+
+	if nf.NumericEntry() != "-42" {
+		t.Errorf("numeric entry is %q.  Expecting -42", nf.NumericEntry())
+	}
+
+	i, err := nf.Int()
+	if err != nil || i != -42 {
+		t.Errorf("Int returned %v, %v.  Expecting -42", i, err)
+	}
+
+	i, err = nf.SetNumericEntry("7.00").Int()
+	if err != nil || i != 7 {
+		t.Errorf("Int returned %v, %v.  Expecting 7", i, err)
+	}
+
+	if _, err := nf.SetNumericEntry("7.5").Int(); err == nil {
+		t.Error("expecting an error for an entry that is not a whole number")
+	}
+}
+
+func Test_NumericFieldIngestInvalidEntry(t *testing.T) {
+	nf := NumericFieldWith{NumericEntry: "5", MinValue: 0, MaxValue: 10, DecimalPlaces: 1}.Make()
+	nf.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	for _, entry := range []string{"five", "11", "-0.5", "2.25", "NaN"} {
+		if err := nf.IngestUpdate(map[any]any{"NumericEntry": entry}); err != nil {
+			t.Errorf("unexpected error for entry %q: %v", entry, err)
+		}
+		if nf.NumericEntry() != entry {
+			t.Errorf("invalid entry %q was not kept", entry)
+		}
+		if nf.ErrorMessage() == "" || nf.Validate() == nil {
+			t.Errorf("no error shown for invalid entry %q", entry)
+		}
+	}
+
+	for _, entry := range []string{"", "0", "10", "2.5", "2.50"} {
+		if err := nf.IngestUpdate(map[any]any{"NumericEntry": entry}); err != nil {
+			t.Errorf("unexpected error for entry %q: %v", entry, err)
+		}
+		if nf.NumericEntry() != entry {
+			t.Errorf("entry %q was not stored", entry)
+		}
+		if nf.ErrorMessage() != "" {
+			t.Errorf("error %q shown for valid entry %q", nf.ErrorMessage(), entry)
+		}
+	}
+}
+
+func Test_NumericFieldIngestWholeNumbers(t *testing.T) {
+	nf := NumericFieldWith{DecimalPlaces: 0}.Make()
+	nf.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	nf.IngestUpdate(map[any]any{"NumericEntry": "1.5"})
+	if nf.ErrorMessage() == "" {
+		t.Error("expecting an error for an entry that is not a whole number")
+	}
+	for _, entry := range []string{"-300", "7.0"} {
+		nf.IngestUpdate(map[any]any{"NumericEntry": entry})
+		if nf.ErrorMessage() != "" {
+			t.Errorf("unexpected error for entry %q: %s", entry, nf.ErrorMessage())
+		}
+	}
+}
+
+func Test_NumericFieldIngestAnyDecimalPlaces(t *testing.T) {
+	nf := NewNumericField("")
+	nf.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	if nf.DecimalPlaces() != AnyDecimalPlaces {
+		t.Fatalf("decimal places are %d.  Expecting no limit by default", nf.DecimalPlaces())
+	}
+	nf.IngestUpdate(map[any]any{"NumericEntry": "3.14159"})
+	if nf.ErrorMessage() != "" {
+		t.Errorf("unexpected error: %s", nf.ErrorMessage())
+	}
+
+	nf.SetDecimalPlaces(2)
+	nf.IngestUpdate(map[any]any{"NumericEntry": "3.145"})
+	if nf.ErrorMessage() == "" {
+		t.Error("expecting an error for an entry with too many decimal places")
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"log/slog"
+
+	"github.com/prontogui/golib/pgcomm"
+	"google.golang.org/grpc"
+)
+
+// An Option configures a ProntoGUI when it is created by NewProntoGUI.
+type Option func(*options)
+
+// The accumulated settings from all options supplied to NewProntoGUI.
+type options struct {
+	grpcServerOptions  []grpc.ServerOption
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	logger             *slog.Logger
+	maxMessageSize     int
+	inboundBufferSize  int
+	outboundBufferSize int
+	blobChunkSize      int
+	flushPolicy        FlushPolicy
+	ingestErrorHandler func(session Session, err *IngestError)
+}
+
+// Adds options to pass along when creating the gRPC server.  This is the way to
+// configure things like TLS credentials or keepalive parameters.  It can be
+// supplied more than once and the options are accumulated.
+func WithGRPCServerOptions(opts ...grpc.ServerOption) Option {
+	return func(o *options) {
+		o.grpcServerOptions = append(o.grpcServerOptions, opts...)
+	}
+}
+
+// Sets the logger used for reporting server activity.  By default, slog.Default()
+// is used.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Sets the maximum size in bytes of a message that can be sent to or received
+// from the App.  By default, the gRPC limits apply (4 MB for receiving).
+func WithMaxMessageSize(size int) Option {
+	return func(o *options) {
+		o.maxMessageSize = size
+	}
+}
+
+// Adds an interceptor for unary gRPC calls.  It can be supplied more than once
+// and the interceptors are chained in the order given.
+func WithUnaryInterceptor(interceptor grpc.UnaryServerInterceptor) Option {
+	return func(o *options) {
+		o.unaryInterceptors = append(o.unaryInterceptors, interceptor)
+	}
+}
+
+// Adds an interceptor for streaming gRPC calls, which includes the streaming of
+// updates with the App.  It can be supplied more than once and the interceptors
+// are chained in the order given.
+func WithStreamInterceptor(interceptor grpc.StreamServerInterceptor) Option {
+	return func(o *options) {
+		o.streamInterceptors = append(o.streamInterceptors, interceptor)
+	}
+}
+
+// Sets the number of updates that can be buffered in each direction for a session.
+// A value of zero or less uses the default buffer size of 2.
+func WithChannelBufferSizes(inbound, outbound int) Option {
+	return func(o *options) {
+		o.inboundBufferSize = inbound
+		o.outboundBufferSize = outbound
+	}
+}
+
+// Sets the maximum number of bytes of a blob (e.g. Image, ImportFile or ExportFile data) that
+// is sent in a single update.  Larger blobs are sent in chunks of this size over successive
+// updates so they don't exceed message size limits or hold up other updates.  The default is
+// DefaultBlobChunkSize and sizes below 16 KB are raised to 16 KB.  Blobs are only sent in
+// chunks to Apps that claim the blob-chunks capability.
+func WithBlobChunkSize(size int) Option {
+	return func(o *options) {
+		o.blobChunkSize = size
+	}
+}
+
+// Sets the flush policy of every session, which controls how often Update sends changes
+// to the App.  See FlushPolicy and MaxUpdateRate.  By default, changes are sent on every
+// call to Update.
+func WithFlushPolicy(policy FlushPolicy) Option {
+	return func(o *options) {
+		o.flushPolicy = policy
+	}
+}
+
+// Sets a function to call, for every session, when an update from the App can't be ingested.
+// This is a way to log bad updates without interrupting the session.  See
+// Session.SetIngestErrorHandler.
+func WithIngestErrorHandler(handler func(session Session, err *IngestError)) Option {
+	return func(o *options) {
+		o.ingestErrorHandler = handler
+	}
+}
+
+// Applies the supplied options on top of the defaults.
+func newOptions(opts ...Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Returns the complete set of options for creating the gRPC server.
+func (o *options) serverOptions() []grpc.ServerOption {
+	serverOptions := append([]grpc.ServerOption{}, o.grpcServerOptions...)
+
+	if o.maxMessageSize > 0 {
+		serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(o.maxMessageSize), grpc.MaxSendMsgSize(o.maxMessageSize))
+	}
+	if len(o.unaryInterceptors) > 0 {
+		serverOptions = append(serverOptions, grpc.ChainUnaryInterceptor(o.unaryInterceptors...))
+	}
+	if len(o.streamInterceptors) > 0 {
+		serverOptions = append(serverOptions, grpc.ChainStreamInterceptor(o.streamInterceptors...))
+	}
+
+	return serverOptions
+}
+
+// Makes the communication layer configured by these options.
+func (o *options) makePGComm() *pgcomm.PGComm {
+	return pgcomm.PGCommWith{
+		ServerOptions:      o.serverOptions(),
+		Logger:             o.logger,
+		InboundBufferSize:  o.inboundBufferSize,
+		OutboundBufferSize: o.outboundBufferSize,
+		Capabilities:       serverCapabilities,
+	}.Make()
+}
@@ -0,0 +1,100 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/pgcomm"
+	"google.golang.org/grpc"
+)
+
+func Test_OptionsDefault(t *testing.T) {
+	o := newOptions()
+
+	if len(o.serverOptions()) != 0 {
+		t.Error("expecting no server options by default")
+	}
+	if o.logger != nil {
+		t.Error("expecting no logger by default")
+	}
+}
+
+func Test_OptionsServerOptions(t *testing.T) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, ss)
+	}
+
+	o := newOptions(
+		WithGRPCServerOptions(grpc.MaxConcurrentStreams(10)),
+		WithMaxMessageSize(1024),
+		WithUnaryInterceptor(unary),
+		WithStreamInterceptor(stream),
+		WithStreamInterceptor(stream),
+	)
+
+	if len(o.streamInterceptors) != 2 {
+		t.Errorf("expecting 2 stream interceptors, got %d", len(o.streamInterceptors))
+	}
+
+	// One supplied option, max send & receive sizes, and one chain for each type of interceptor
+	if len(o.serverOptions()) != 5 {
+		t.Errorf("expecting 5 server options, got %d", len(o.serverOptions()))
+	}
+}
+
+func Test_OptionsLoggerAndBuffers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	o := newOptions(WithLogger(logger), WithChannelBufferSizes(5, 10))
+
+	if o.logger != logger {
+		t.Error("logger was not assigned")
+	}
+	if o.inboundBufferSize != 5 || o.outboundBufferSize != 10 {
+		t.Error("channel buffer sizes were not assigned")
+	}
+}
+
+func Test_NewProntoGUIWithOptions(t *testing.T) {
+	pg := NewProntoGUI(WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), WithMaxMessageSize(16*1024*1024))
+	err := pg.StartServingSingle("", 0)
+	if err != nil {
+		t.Fatalf("StartServingSingle failed: %v", err)
+	}
+	pg.StopServing()
+}
+
+func Test_OptionsFlushPolicy(t *testing.T) {
+	o := newOptions(WithFlushPolicy(MaxUpdateRate(10)))
+
+	s := newSession(&pgcomm.StreamingAPICall{}, o)
+	if s.flushPolicy.MinInterval != 100*time.Millisecond {
+		t.Error("flush policy was not applied to the session")
+	}
+}
+
+func Test_OptionsIngestErrorHandler(t *testing.T) {
+	var handledBy Session
+	o := newOptions(WithIngestErrorHandler(func(session Session, err *IngestError) {
+		handledBy = session
+	}))
+
+	s := newSession(&pgcomm.StreamingAPICall{}, o)
+	s.ingestErrorHandler(&IngestError{Reason: ErrMalformedUpdate})
+
+	if handledBy != s {
+		t.Error("ingest error handler was not called with the session")
+	}
+}
@@ -12,10 +12,22 @@ import (
 	"io"
 	"log/slog"
 	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 
 	pb "github.com/prontogui/golib/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	// Registers the gzip compressor.  Updates sent to a client are compressed with gzip if the
+	// client compresses its own updates with gzip.
+	_ "google.golang.org/grpc/encoding/gzip"
 )
 
 // StreamingAPICall represents a single client streaming API call with channels for communication.
@@ -28,12 +40,182 @@ type StreamingAPICall struct {
 
 	// Signals that API call has exited
 	CallHasExited chan byte
+
+	// Metadata sent by the client when making the call, such as the capabilities it supports.
+	Metadata metadata.MD
+
+	// The protocol version agreed upon with the client.
+	ProtocolVersion int
+
+	// The capabilities supported by both the client and the server.
+	Capabilities []string
+
+	// True if the client and server have no protocol version in common.  The call ends
+	// right away in this case.
+	Incompatible bool
+
+	// The network address of the client, or empty if not known.
+	PeerAddr string
+
+	// Closed by Abort to end the call.
+	aborted   chan byte
+	abortOnce sync.Once
+}
+
+// Ends the call with an error status, such as when the session using it has failed.  The
+// client is disconnected and is free to connect again.
+func (apicall *StreamingAPICall) Abort() {
+	apicall.abortOnce.Do(func() {
+		if apicall.aborted != nil {
+			close(apicall.aborted)
+		}
+	})
+}
+
+// Returns true if the capability is supported by both the client and the server.
+func (apicall *StreamingAPICall) HasCapability(capability string) bool {
+	return slices.Contains(apicall.Capabilities, capability)
+}
+
+// The range of protocol versions supported by this server.
+const (
+	MinProtocolVersion = 1
+	ProtocolVersion    = 1
+)
+
+// Metadata keys used for the handshake at the start of each streaming API call.  The client
+// sends the protocol versions and capabilities it supports.  The server replies in the header
+// with the protocol version it chose, or every version it supports if there are none in
+// common, along with the capabilities it supports.  A client that sends no protocol version
+// is assumed to support version 1 and no capabilities.  Lists of values can be given as
+// separate values or separated by commas.
+const (
+	ProtocolVersionMetadataKey = "pg-protocol-version"
+	CapabilitiesMetadataKey    = "pg-capabilities"
+)
+
+// Splits metadata values that hold comma-separated lists into a single list.
+func SplitMetadataValues(values []string) []string {
+	var items []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// Returns the highest protocol version supported by both the client and the server,
+// or zero if there is none.
+func negotiateProtocolVersion(md metadata.MD) int {
+	versions := SplitMetadataValues(md.Get(ProtocolVersionMetadataKey))
+	if len(versions) == 0 {
+		return MinProtocolVersion
+	}
+
+	chosen := 0
+	for _, v := range versions {
+		version, err := strconv.Atoi(v)
+		if err == nil && version >= MinProtocolVersion && version <= ProtocolVersion && version > chosen {
+			chosen = version
+		}
+	}
+	return chosen
+}
+
+// Returns the capabilities supported by both the client and the server.
+func (pgc *PGComm) negotiateCapabilities(md metadata.MD) []string {
+	var capabilities []string
+	for _, c := range SplitMetadataValues(md.Get(CapabilitiesMetadataKey)) {
+		if slices.Contains(pgc.capabilities, c) {
+			capabilities = append(capabilities, c)
+		}
+	}
+	return capabilities
+}
+
+// Makes the header sent to the client in reply to the handshake.
+func (pgc *PGComm) makeHandshakeHeader(version int) metadata.MD {
+	header := metadata.MD{}
+	if version != 0 {
+		header.Set(ProtocolVersionMetadataKey, strconv.Itoa(version))
+	} else {
+		for v := MinProtocolVersion; v <= ProtocolVersion; v++ {
+			header.Append(ProtocolVersionMetadataKey, strconv.Itoa(v))
+		}
+	}
+	if len(pgc.capabilities) > 0 {
+		header.Set(CapabilitiesMetadataKey, pgc.capabilities...)
+	}
+	return header
+}
+
+// The buffer size used for Inbound and Outbound channels when none is specified.
+const DefaultChannelBufferSize = 2
+
+// Settings used for making a new PGComm.
+type PGCommWith struct {
+	// Options passed along to grpc.NewServer, such as credentials, keepalive parameters,
+	// message size limits, or interceptors.
+	ServerOptions []grpc.ServerOption
+
+	// Logger for reporting server activity.  If nil, then slog.Default() is used.
+	Logger *slog.Logger
+
+	// Buffer size of the Inbound channel for each streaming API call.  If this is zero
+	// or less, then DefaultChannelBufferSize is used.
+	InboundBufferSize int
+
+	// Buffer size of the Outbound channel for each streaming API call.  If this is zero
+	// or less, then DefaultChannelBufferSize is used.
+	OutboundBufferSize int
+
+	// Capabilities supported by the server, which are offered to clients in the handshake.
+	Capabilities []string
+}
+
+// Makes a new PGComm using the supplied settings.
+func (w PGCommWith) Make() *PGComm {
+	pgc := &PGComm{
+		serverOptions:      w.ServerOptions,
+		logger:             w.Logger,
+		inboundBufferSize:  w.InboundBufferSize,
+		outboundBufferSize: w.OutboundBufferSize,
+		capabilities:       w.Capabilities,
+	}
+
+	if pgc.logger == nil {
+		pgc.logger = slog.Default()
+	}
+	if pgc.inboundBufferSize <= 0 {
+		pgc.inboundBufferSize = DefaultChannelBufferSize
+	}
+	if pgc.outboundBufferSize <= 0 {
+		pgc.outboundBufferSize = DefaultChannelBufferSize
+	}
+
+	return pgc
 }
 
 // Implementation of the PGServer
 type PGComm struct {
 	pb.UnimplementedPGServiceServer
 
+	// Options for creating the gRPC server.
+	serverOptions []grpc.ServerOption
+
+	// Logger for reporting server activity.
+	logger *slog.Logger
+
+	// Buffer sizes for the Inbound and Outbound channels of each streaming API call.
+	inboundBufferSize  int
+	outboundBufferSize int
+
+	// Capabilities supported by the server.
+	capabilities []string
+
 	// The active server.
 	activeServer *grpc.Server
 
@@ -51,7 +233,7 @@ type PGComm struct {
 }
 
 func NewPGComm() *PGComm {
-	return &PGComm{}
+	return PGCommWith{}.Make()
 }
 
 // Blocks until a client connects or the server stops.
@@ -85,14 +267,36 @@ func (pgc *PGComm) StreamUpdates(stream grpc.BidiStreamingServer[pb.PGUpdate, pb
 	}()
 
 	apicall := &StreamingAPICall{
-		Inbound:       make(chan []byte, 2),
-		Outbound:      make(chan []byte, 2),
+		Inbound:       make(chan []byte, pgc.inboundBufferSize),
+		Outbound:      make(chan []byte, pgc.outboundBufferSize),
 		CallHasExited: make(chan byte),
+		aborted:       make(chan byte),
+	}
+
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		apicall.PeerAddr = p.Addr.String()
+	}
+
+	// Handshake with the client
+	apicall.Metadata, _ = metadata.FromIncomingContext(stream.Context())
+	apicall.ProtocolVersion = negotiateProtocolVersion(apicall.Metadata)
+	apicall.Capabilities = pgc.negotiateCapabilities(apicall.Metadata)
+	apicall.Incompatible = apicall.ProtocolVersion == 0
+
+	if err := stream.SendHeader(pgc.makeHandshakeHeader(apicall.ProtocolVersion)); err != nil {
+		return err
 	}
 
 	// Deliver this session to AcceptSession.
 	pgc.acceptChan <- apicall
 
+	if apicall.Incompatible {
+		pgc.logger.Warn("client has no protocol version in common with the server", "versions", apicall.Metadata.Get(ProtocolVersionMetadataKey))
+		close(apicall.CallHasExited)
+		close(apicall.Inbound)
+		return status.Error(codes.FailedPrecondition, "no protocol version in common with the server")
+	}
+
 	cancelOutbound := make(chan bool)
 
 	// Launch goroutine to send outbound updates to the client.
@@ -113,27 +317,36 @@ func (pgc *PGComm) StreamUpdates(stream grpc.BidiStreamingServer[pb.PGUpdate, pb
 		}
 	}()
 
-	// Receive updates from the client.
-	var err error
-	for {
-		uxs := pb.PGUpdate{}
-		err = stream.RecvMsg(&uxs)
-		if err != nil {
-			break
-		}
+	// Launch goroutine to receive updates from the client.  It ends once the call has exited,
+	// which also makes RecvMsg return.
+	received := make(chan error, 1)
+	go func() {
+		defer close(apicall.Inbound)
+		for {
+			uxs := pb.PGUpdate{}
+			if err := stream.RecvMsg(&uxs); err != nil {
+				received <- err
+				return
+			}
 
-		select {
-		case apicall.Inbound <- uxs.Cbor:
-			continue
-		case <-pgc.StopAllStreaming:
-			goto cleanup
+			select {
+			case apicall.Inbound <- uxs.Cbor:
+			case <-apicall.CallHasExited:
+				return
+			}
 		}
+	}()
+
+	var err error
+	select {
+	case err = <-received:
+	case <-apicall.aborted:
+		pgc.logger.Warn("call was aborted", "client", apicall.PeerAddr)
+		err = status.Error(codes.Aborted, "session failed")
+	case <-pgc.StopAllStreaming:
 	}
 
-cleanup:
 	close(apicall.CallHasExited)
-
-	close(apicall.Inbound)
 	close(cancelOutbound)
 
 	if err == io.EOF {
@@ -157,23 +370,23 @@ func (pgc *PGComm) StartServing(addr string, port int, maxAPICalls int) error {
 
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
-		slog.Error("could not listen for network connection", "address", address, "error", err)
+		pgc.logger.Error("could not listen for network connection", "address", address, "error", err)
 		return err
 	}
 
-	pgc.activeServer = grpc.NewServer()
+	pgc.activeServer = grpc.NewServer(pgc.serverOptions...)
 	pb.RegisterPGServiceServer(pgc.activeServer, pgc)
 
-	slog.Info("server is now listening", "address", address)
+	pgc.logger.Info("server is now listening", "address", address)
 
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
-				slog.Info("server stopped", "address", address)
+				pgc.logger.Info("server stopped", "address", address)
 			}
 		}()
 		if err := pgc.activeServer.Serve(lis); err != nil {
-			slog.Error("error occurred while serving", "address", address, "error", err)
+			pgc.logger.Error("error occurred while serving", "address", address, "error", err)
 		}
 	}()
 
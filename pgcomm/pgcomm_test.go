@@ -7,9 +7,12 @@
 package pgcomm
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/prontogui/golib/testhelp"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
 )
 
 func Test_serve_badport(t *testing.T) {
@@ -46,3 +49,70 @@ func Test_double_start(t *testing.T) {
 	err = pgc.StartServing("", 0, 1)
 	testhelp.TestErrorMessage(t, err, "PGComm serving already started")
 }
+
+func Test_make_defaults(t *testing.T) {
+	pgc := PGCommWith{}.Make()
+
+	if pgc.logger == nil {
+		t.Error("expecting a default logger")
+	}
+	if pgc.inboundBufferSize != DefaultChannelBufferSize || pgc.outboundBufferSize != DefaultChannelBufferSize {
+		t.Error("expecting default channel buffer sizes")
+	}
+}
+
+func Test_make_buffer_sizes(t *testing.T) {
+	pgc := PGCommWith{InboundBufferSize: 3, OutboundBufferSize: 7}.Make()
+
+	if pgc.inboundBufferSize != 3 || pgc.outboundBufferSize != 7 {
+		t.Error("channel buffer sizes were not assigned")
+	}
+}
+
+func Test_gzip_registered(t *testing.T) {
+	if encoding.GetCompressor("gzip") == nil {
+		t.Fatal("gzip compressor is not registered")
+	}
+}
+
+func Test_negotiate_protocol_version(t *testing.T) {
+	if v := negotiateProtocolVersion(metadata.MD{}); v != MinProtocolVersion {
+		t.Errorf("version is %d for a client without a version.  Expecting %d", v, MinProtocolVersion)
+	}
+
+	md := metadata.Pairs(ProtocolVersionMetadataKey, "0, "+strconv.Itoa(ProtocolVersion), ProtocolVersionMetadataKey, strconv.Itoa(ProtocolVersion+1))
+	if v := negotiateProtocolVersion(md); v != ProtocolVersion {
+		t.Errorf("version is %d.  Expecting %d", v, ProtocolVersion)
+	}
+
+	md = metadata.Pairs(ProtocolVersionMetadataKey, strconv.Itoa(ProtocolVersion+1))
+	if v := negotiateProtocolVersion(md); v != 0 {
+		t.Errorf("version is %d for a client without a version in common.  Expecting 0", v)
+	}
+}
+
+func Test_negotiate_capabilities(t *testing.T) {
+	pgc := PGCommWith{Capabilities: []string{"a", "b"}}.Make()
+
+	capabilities := pgc.negotiateCapabilities(metadata.Pairs(CapabilitiesMetadataKey, "b,c"))
+	if len(capabilities) != 1 || capabilities[0] != "b" {
+		t.Errorf("capabilities are %v.  Expecting [b]", capabilities)
+	}
+}
+
+func Test_handshake_header(t *testing.T) {
+	pgc := PGCommWith{Capabilities: []string{"a", "b"}}.Make()
+
+	header := pgc.makeHandshakeHeader(ProtocolVersion)
+	if v := header.Get(ProtocolVersionMetadataKey); len(v) != 1 || v[0] != strconv.Itoa(ProtocolVersion) {
+		t.Errorf("header has version %v.  Expecting %d", v, ProtocolVersion)
+	}
+	if c := header.Get(CapabilitiesMetadataKey); len(c) != 2 {
+		t.Errorf("header has capabilities %v.  Expecting [a b]", c)
+	}
+
+	header = pgc.makeHandshakeHeader(0)
+	if v := header.Get(ProtocolVersionMetadataKey); len(v) != ProtocolVersion-MinProtocolVersion+1 {
+		t.Errorf("header has versions %v.  Expecting all supported versions", v)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/prontogui/golib/key"
+)
+
+// A point of a chart.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Names of the items in a map that represents points appended to a PointsField.  This is used
+// in place of all the points when only new points need to be sent to the App.  The App appends
+// the points and then drops the oldest points to keep no more than the window size, if any.
+const (
+	pointsAppend = "Append"
+	pointsWindow = "Window"
+)
+
+type PointsField struct {
+	FieldBase
+	points []Point
+
+	// The number of points at the end that haven't been sent to the App.
+	unsent int
+
+	// The window size used when the unsent points were appended.
+	window int
+
+	// True if all the points need to be sent to the App, rather than just the unsent points.
+	replaced bool
+}
+
+func (f *PointsField) Get() []Point {
+	return f.points
+}
+
+// Sets the points, which are copied.  All of them are sent to the App.
+func (f *PointsField) Set(points []Point) {
+	f.points = slices.Clone(points)
+	f.unsent = 0
+	f.replaced = true
+	f.OnSet(false)
+}
+
+// Appends points and then drops the oldest points to keep no more than window points, if
+// window is greater than zero.  Only the appended points are sent to the App in a partial
+// update.
+func (f *PointsField) Append(window int, points ...Point) {
+	if len(points) == 0 {
+		return
+	}
+
+	// The App trims the points it has once for all the points appended, which only gives the
+	// same points if they were all appended with the same window size
+	if f.unsent > 0 && f.window != window {
+		f.replaced = true
+	}
+	f.window = window
+
+	f.points = append(f.points, points...)
+	f.unsent += len(points)
+
+	if window > 0 && len(f.points) > window {
+		f.points = f.points[len(f.points)-window:]
+	}
+
+	// Send all the points if the App wouldn't keep any of the points it has
+	if f.unsent >= len(f.points) {
+		f.replaced = true
+	}
+
+	f.OnSet(false)
+}
+
+func (f *PointsField) PrepareForUpdates(fkey key.FKey, pkey key.PKey, fieldPKeyIndex int, onset key.OnSetFunction, etsprovider EventTimestampProvider) (isContainer bool) {
+	f.StashUpdateInfo(fkey, pkey, fieldPKeyIndex, onset, etsprovider)
+	return false
+}
+
+// Egests all the points, which the App then has.
+func (f *PointsField) EgestValue() any {
+	f.unsent = 0
+	f.replaced = false
+
+	points := make([][]float64, len(f.points))
+	for i, p := range f.points {
+		points[i] = []float64{p.X, p.Y}
+	}
+	return points
+}
+
+// Egests just the points that haven't been sent to the App, in a map, if the App has the
+// others.  Otherwise all the points are egested.  This is only used for Apps that support the
+// map, which the Synchro checks by way of pointsEgest.
+func (f *PointsField) egestAppended() any {
+	if f.replaced {
+		return f.EgestValue()
+	}
+
+	appended := make([][]float64, 0, f.unsent)
+	for _, p := range f.points[len(f.points)-f.unsent:] {
+		appended = append(appended, []float64{p.X, p.Y})
+	}
+	f.unsent = 0
+
+	return map[any]any{pointsAppend: appended, pointsWindow: f.window}
+}
+
+func (f *PointsField) IngestValue(value any) error {
+
+	l, ok := value.([]any)
+	if !ok {
+		return errors.New("unable to convert value (any) to field value")
+	}
+
+	points := make([]Point, len(l))
+	for i, v := range l {
+		xy, ok := v.([]any)
+		if !ok || len(xy) != 2 {
+			return fmt.Errorf("value[%d] is not a point", i)
+		}
+		x, errx := ConvertAnyToFloat(xy[0])
+		y, erry := ConvertAnyToFloat(xy[1])
+		if errx != nil || erry != nil {
+			return fmt.Errorf("value[%d] is not a point", i)
+		}
+		points[i] = Point{X: x, Y: y}
+	}
+
+	f.points = points
+	f.unsent = 0
+	f.replaced = false
+	return nil
+}
+
+// The points of a PointsField egested in a partial update.  The Synchro replaces this with just
+// the points appended since the last update, if the App supports it, or all the points.
+type pointsEgest struct {
+	field *PointsField
+}
+
+// Encodes all the points for cases where the Synchro did not handle it.  Implements the
+// cbor.Marshaler interface.
+func (p *pointsEgest) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(p.field.EgestValue())
+}
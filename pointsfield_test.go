@@ -0,0 +1,113 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_PointsFieldSetAndEgest(t *testing.T) {
+	f := PointsField{}
+	f.Set([]Point{{1, 2}, {3, 4}})
+
+	if !reflect.DeepEqual(f.EgestValue(), [][]float64{{1, 2}, {3, 4}}) {
+		t.Errorf("egested %v.  Expecting all the points", f.EgestValue())
+	}
+}
+
+func Test_PointsFieldSetCopies(t *testing.T) {
+	points := make([]Point, 2, 10)
+	f := PointsField{}
+	f.Set(points)
+
+	f.Append(0, Point{5, 6})
+	points = append(points, Point{7, 8})
+
+	if f.Get()[2] != (Point{5, 6}) {
+		t.Error("appending to the caller's points changed the field")
+	}
+}
+
+func Test_PointsFieldAppend(t *testing.T) {
+	f := PointsField{}
+	f.Set([]Point{{1, 2}, {3, 4}})
+	f.EgestValue()
+
+	f.Append(0, Point{5, 6})
+	want := map[any]any{pointsAppend: [][]float64{{5, 6}}, pointsWindow: 0}
+	if got := f.egestAppended(); !reflect.DeepEqual(got, want) {
+		t.Errorf("egested %v.  Expecting only the new point", got)
+	}
+
+	// Nothing more to append once sent
+	f.Append(0, Point{7, 8}, Point{9, 10})
+	want = map[any]any{pointsAppend: [][]float64{{7, 8}, {9, 10}}, pointsWindow: 0}
+	if got := f.egestAppended(); !reflect.DeepEqual(got, want) {
+		t.Errorf("egested %v.  Expecting only the new points", got)
+	}
+}
+
+func Test_PointsFieldAppendWindow(t *testing.T) {
+	f := PointsField{}
+	f.Append(3, Point{1, 1}, Point{2, 2})
+
+	// All the points are sent when the App doesn't have them yet
+	if got := f.egestAppended(); !reflect.DeepEqual(got, [][]float64{{1, 1}, {2, 2}}) {
+		t.Errorf("egested %v.  Expecting all the points", got)
+	}
+
+	f.Append(3, Point{3, 3}, Point{4, 4})
+	if !slices.Equal(f.Get(), []Point{{2, 2}, {3, 3}, {4, 4}}) {
+		t.Errorf("points are %v.  Expecting the oldest point to be dropped", f.Get())
+	}
+	want := map[any]any{pointsAppend: [][]float64{{3, 3}, {4, 4}}, pointsWindow: 3}
+	if got := f.egestAppended(); !reflect.DeepEqual(got, want) {
+		t.Errorf("egested %v.  Expecting only the new points", got)
+	}
+
+	// A different window is applied by the App when appending
+	f.Append(2, Point{5, 5})
+	want = map[any]any{pointsAppend: [][]float64{{5, 5}}, pointsWindow: 2}
+	if got := f.egestAppended(); !reflect.DeepEqual(got, want) {
+		t.Errorf("egested %v.  Expecting only the new point", got)
+	}
+
+	// All the points are sent if the window changes between appends
+	f.Append(2, Point{6, 6})
+	f.Append(3, Point{7, 7})
+	if got := f.egestAppended(); !reflect.DeepEqual(got, [][]float64{{5, 5}, {6, 6}, {7, 7}}) {
+		t.Errorf("egested %v.  Expecting all the points", got)
+	}
+}
+
+func Test_PointsFieldAppendOnSet(t *testing.T) {
+	f := PointsField{}
+	onsetCalled := false
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, func(key.PKey, key.FKey, bool) { onsetCalled = true }, nil)
+
+	f.Append(0, Point{1, 2})
+	if !onsetCalled {
+		t.Error("onset was not called")
+	}
+}
+
+func Test_PointsFieldIngestValue(t *testing.T) {
+	f := PointsField{}
+	if err := f.IngestValue([]any{[]any{1.5, uint64(2)}, []any{3.0, -4.0}}); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(f.Get(), []Point{{1.5, 2}, {3, -4}}) {
+		t.Errorf("points are %v", f.Get())
+	}
+	if f.IngestValue([]any{[]any{1.0}}) == nil {
+		t.Error("expecting an error for a point without a Y value")
+	}
+}
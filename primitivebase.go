@@ -7,8 +7,6 @@
 package golib
 
 import (
-	"errors"
-
 	"github.com/prontogui/golib/key"
 )
 
@@ -58,6 +56,23 @@ func (r *PrimitiveBase) LocateNextDescendant(locator *key.PKeyLocator) Primitive
 	return nil
 }
 
+// Returns the item at index, or nil if the index is out of range.  This is used when locating
+// descendants by PKey, which might not be valid if it came from the App.
+func itemAt(items []Primitive, index int) Primitive {
+	if index < 0 || index >= len(items) {
+		return nil
+	}
+	return items[index]
+}
+
+// Returns the item at row and col, or nil if either is out of range.
+func cellAt(rows [][]Primitive, row, col int) Primitive {
+	if row < 0 || row >= len(rows) {
+		return nil
+	}
+	return itemAt(rows[row], col)
+}
+
 func (r *PrimitiveBase) findField(fkey key.FKey) Field {
 
 	var found Field
@@ -85,9 +100,10 @@ func (r *PrimitiveBase) EgestUpdate(fullupdate bool, fkeys []key.FKey) map[any]a
 	} else {
 		for _, fkey := range fkeys {
 
+			// A field that isn't in the primitive has no value to send
 			field := r.findField(fkey)
 			if field == nil {
-				panic("field not found in primitive")
+				continue
 			}
 
 			fieldvalue := field.EgestValue()
@@ -108,12 +124,12 @@ func (r *PrimitiveBase) IngestUpdate(update map[any]any) error {
 
 		ks, ok := k.(string)
 		if !ok {
-			return errors.New("invalid key type.  Expecting a string")
+			return malformedUpdateError("invalid key type.  Expecting a string")
 		}
 
 		fkey := key.FKeyFor(ks)
 		if fkey == key.INVALID_FIELDNAME {
-			return errors.New("invalid field name")
+			return unknownFieldError(ks, "invalid field name")
 		}
 
 		var found Field
@@ -125,12 +141,12 @@ func (r *PrimitiveBase) IngestUpdate(update map[any]any) error {
 		}
 
 		if found == nil {
-			return errors.New("no matching field name in primitive")
+			return unknownFieldError(ks, "no matching field name in primitive")
 		}
 
 		err := found.IngestValue(v)
 		if err != nil {
-			return err
+			return invalidValueError(ks, err)
 		}
 	}
 
@@ -303,3 +303,16 @@ func Test_IndexOf(t *testing.T) {
 	testfunc(-1, -1)
 	testfunc(-10, -1)
 }
+
+func Test_EgestPartialUpdateFieldNotInPrimitive(t *testing.T) {
+
+	tp := SimplePrimitive{}
+	tp.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+	tp.Label.Set("abc")
+
+	update := tp.EgestUpdate(false, []key.FKey{key.FKeyFor("Label"), key.FKeyFor("Choices")})
+
+	if len(update) != 1 || update["Label"] != "abc" {
+		t.Fatalf("update is %v.  Expecting only the Label field", update)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// An indicator showing the progress of a long-running task.
+type ProgressWith struct {
+	Embodiment    string
+	Indeterminate bool
+	Label         string
+	Status        int
+	Tag           string
+	Value         float64
+}
+
+// Creates a new Progress using the supplied field assignments.
+func (w ProgressWith) Make() *Progress {
+	progress := &Progress{}
+	progress.embodiment.Set(w.Embodiment)
+	progress.indeterminate.Set(w.Indeterminate)
+	progress.label.Set(w.Label)
+	progress.status.Set(w.Status)
+	progress.tag.Set(w.Tag)
+	progress.SetValue(w.Value)
+	return progress
+}
+
+// An indicator showing the progress of a long-running task.
+//
+// A determinate indicator shows how much of the task is done, as a value from 0 to 1.  An
+// indeterminate indicator only shows that the task is in progress.
+type Progress struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment    StringField
+	indeterminate BooleanField
+	label         StringField
+	status        IntegerField
+	tag           StringField
+	value         FloatField
+}
+
+// Creates a new determinate Progress with a label and no progress made.
+func NewProgress(label string) *Progress {
+	return ProgressWith{Label: label}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (progress *Progress) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	progress.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &progress.embodiment},
+			{key.FKey_Indeterminate, &progress.indeterminate},
+			{key.FKey_Label, &progress.label},
+			{key.FKey_Status, &progress.status},
+			{key.FKey_Tag, &progress.tag},
+			{key.FKey_Value, &progress.value},
+		}
+	})
+}
+
+// Returns a string representation of this primitive:  the label followed by the percentage
+// done, if determinate.  Implements of fmt:Stringer interface.
+func (progress *Progress) String() string {
+	if progress.indeterminate.Get() {
+		return progress.label.Get()
+	}
+	percent := strconv.Itoa(int(math.Round(progress.value.Get()*100))) + "%"
+	if progress.label.Get() == "" {
+		return percent
+	}
+	return progress.label.Get() + " " + percent
+}
+
+// Returns how much of the task is done, from 0 to 1.
+func (progress *Progress) Value() float64 {
+	return progress.value.Get()
+}
+
+// Sets how much of the task is done, from 0 to 1.  Values outside of this range are clamped.
+func (progress *Progress) SetValue(v float64) *Progress {
+	progress.value.Set(math.Max(0, math.Min(1, v)))
+	return progress
+}
+
+// Returns true if the indicator only shows that the task is in progress, rather than how much
+// of it is done.
+func (progress *Progress) Indeterminate() bool {
+	return progress.indeterminate.Get()
+}
+
+// Sets whether the indicator only shows that the task is in progress, rather than how much of
+// it is done.
+func (progress *Progress) SetIndeterminate(b bool) *Progress {
+	progress.indeterminate.Set(b)
+	return progress
+}
+
+// Returns the label describing the task.
+func (progress *Progress) Label() string {
+	return progress.label.Get()
+}
+
+// Sets the label describing the task.
+func (progress *Progress) SetLabel(s string) *Progress {
+	progress.label.Set(s)
+	return progress
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (progress *Progress) Embodiment() string {
+	return progress.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (progress *Progress) SetEmbodiment(s string) *Progress {
+	progress.embodiment.Set(s)
+	return progress
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using Progresses as Table cells.
+func (progress *Progress) Tag() string {
+	return progress.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using Progresses as Table cells.
+func (progress *Progress) SetTag(s string) *Progress {
+	progress.tag.Set(s)
+	return progress
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Progress) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Progress) SetStatus(i int) *Progress {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Progress) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Progress) SetVisible(visible bool) *Progress {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Progress) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Progress) SetEnabled(enabled bool) *Progress {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Progress) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Progress) SetCollapsed(collapsed bool) *Progress {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
+
+// Sends the current GUI state to the App without waiting for an update.  This is implemented
+// by Session and by ProntoGUI in single-connection mode.
+type Updater interface {
+	Update() (Primitive, error)
+}
+
+// How often TrackProgress sends the progress of a task to the App.
+const trackProgressInterval = 100 * time.Millisecond
+
+// Runs a long-running task and shows its progress using a Progress primitive.  The task is
+// given a context and a report function to call with how much of it is done, from 0 to 1, as it
+// goes.  Reports outside of 0 to 1 are clamped and reports that are not a number are ignored.
+// The task runs in a separate goroutine, so it must not use the GUI, while this function sends
+// the latest progress to the App using updater at a sensible rate.
+//
+// Each primitive updated by the App while the task is running is passed to updated, unless it
+// is nil.  It is called from this function's goroutine, so it can use the GUI, such as to cancel
+// the context when the user issues a Cancel command.
+//
+// TrackProgress returns when the task is done, with the progress set to 1, or returns
+// ErrCanceled if the context is canceled first.  The task's context is canceled once this
+// function returns, so the task should watch it to stop early.  An error from updater is
+// returned right away.
+func TrackProgress(ctx context.Context, updater Updater, progress *Progress, task func(ctx context.Context, report func(float64)), updated func(Primitive)) error {
+
+	var latest atomic.Uint64
+	latest.Store(math.Float64bits(progress.Value()))
+
+	report := func(v float64) {
+		if !math.IsNaN(v) {
+			latest.Store(math.Float64bits(math.Max(0, math.Min(1, v))))
+		}
+	}
+
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		task(taskCtx, report)
+	}()
+
+	// Sends the progress to the App and passes back any primitive it updated
+	update := func() error {
+		p, err := updater.Update()
+		if p != nil && updated != nil {
+			updated(p)
+		}
+		return err
+	}
+
+	ticker := time.NewTicker(trackProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			progress.SetValue(1)
+			return update()
+		case <-ctx.Done():
+			return ErrCanceled
+		case <-ticker.C:
+			progress.SetValue(math.Float64frombits(latest.Load()))
+			if err := update(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_ProgressAttach(t *testing.T) {
+	progress := &Progress{}
+	progress.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, progress.PrimitiveBase, "Embodiment", "Indeterminate", "Label", "Status", "Tag", "Value")
+}
+
+func Test_ProgressMake(t *testing.T) {
+	progress := ProgressWith{Label: "Copying", Value: 0.424}.Make()
+
+	if progress.String() != "Copying 42%" {
+		t.Errorf("string is %q", progress.String())
+	}
+
+	progress.SetIndeterminate(true)
+	if !progress.Indeterminate() || progress.String() != "Copying" {
+		t.Error("could not set Indeterminate field")
+	}
+}
+
+func Test_ProgressValueClamped(t *testing.T) {
+	progress := NewProgress("")
+
+	if progress.SetValue(1.5).Value() != 1 {
+		t.Error("value above 1 was not clamped")
+	}
+	if progress.SetValue(-0.5).Value() != 0 {
+		t.Error("value below 0 was not clamped")
+	}
+}
+
+// An Updater that records the progress at each update.
+type progressRecorder struct {
+	progress *Progress
+	values   []float64
+	err      error
+
+	// The primitive to return as updated by the App, if any.
+	updated Primitive
+}
+
+func (r *progressRecorder) Update() (Primitive, error) {
+	r.values = append(r.values, r.progress.Value())
+	return r.updated, r.err
+}
+
+func Test_TrackProgress(t *testing.T) {
+	progress := NewProgress("Working")
+	recorder := &progressRecorder{progress: progress}
+
+	err := TrackProgress(context.Background(), recorder, progress, func(ctx context.Context, report func(float64)) {
+		report(0.5)
+		time.Sleep(3 * trackProgressInterval / 2)
+		report(0.75)
+	}, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.values) < 2 {
+		t.Fatalf("progress was updated %d times.  Expecting at least 2", len(recorder.values))
+	}
+	if recorder.values[0] != 0.5 {
+		t.Errorf("first update has progress %v.  Expecting 0.5", recorder.values[0])
+	}
+	if recorder.values[len(recorder.values)-1] != 1 {
+		t.Error("progress is not complete after the task is done")
+	}
+}
+
+func Test_TrackProgressCanceled(t *testing.T) {
+	progress := NewProgress("Working")
+	recorder := &progressRecorder{progress: progress}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	defer close(release)
+
+	go func() {
+		time.Sleep(trackProgressInterval / 2)
+		cancel()
+	}()
+
+	err := TrackProgress(ctx, recorder, progress, func(ctx context.Context, report func(float64)) {
+		<-release
+	}, nil)
+
+	if !errors.Is(err, ErrCanceled) {
+		t.Errorf("error is %v.  Expecting ErrCanceled", err)
+	}
+}
+
+func Test_TrackProgressUpdateError(t *testing.T) {
+	progress := NewProgress("Working")
+	recorder := &progressRecorder{progress: progress, err: ErrSessionEnded}
+	release := make(chan struct{})
+	defer close(release)
+
+	err := TrackProgress(context.Background(), recorder, progress, func(ctx context.Context, report func(float64)) {
+		<-release
+	}, nil)
+
+	if !errors.Is(err, ErrSessionEnded) {
+		t.Errorf("error is %v.  Expecting ErrSessionEnded", err)
+	}
+}
+
+func Test_TrackProgressReportClamped(t *testing.T) {
+	progress := NewProgress("Working")
+	recorder := &progressRecorder{progress: progress}
+
+	err := TrackProgress(context.Background(), recorder, progress, func(ctx context.Context, report func(float64)) {
+		report(2)
+		time.Sleep(3 * trackProgressInterval / 2)
+		report(math.NaN())
+		time.Sleep(trackProgressInterval)
+		report(-1)
+		time.Sleep(trackProgressInterval)
+	}, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.values) < 3 {
+		t.Fatalf("progress was updated %d times.  Expecting at least 3", len(recorder.values))
+	}
+	if recorder.values[0] != 1 || recorder.values[1] != 1 {
+		t.Errorf("progress is %v.  Expecting a report above 1 to be clamped and NaN to be ignored", recorder.values[:2])
+	}
+	if !slices.Contains(recorder.values, 0) {
+		t.Error("a report below 0 was not clamped")
+	}
+}
+
+func Test_TrackProgressCanceledFromGUI(t *testing.T) {
+	progress := NewProgress("Working")
+	cancelCmd := NewCommand("Cancel")
+	recorder := &progressRecorder{progress: progress, updated: cancelCmd}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	taskStopped := make(chan struct{})
+
+	err := TrackProgress(ctx, recorder, progress, func(ctx context.Context, report func(float64)) {
+		<-ctx.Done()
+		close(taskStopped)
+	}, func(p Primitive) {
+		if p == cancelCmd {
+			cancel()
+		}
+	})
+
+	if !errors.Is(err, ErrCanceled) {
+		t.Errorf("error is %v.  Expecting ErrCanceled", err)
+	}
+	select {
+	case <-taskStopped:
+	case <-time.After(time.Second):
+		t.Error("the task's context was not canceled")
+	}
+}
@@ -9,6 +9,7 @@ package golib
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/prontogui/golib/pgcomm"
 )
@@ -48,8 +49,9 @@ type ProntoGUI interface {
 	// AcceptSession blocks until a new client connects and returns a Session
 	// for that client. Only valid in multi-connection mode (after calling
 	// StartServingMultiple); returns an error if called in single-connection mode.
-	// It returns ErrCanceled if the context was canceled or ErrInterrupted if
-	// the caller interrupted the operation.
+	// It returns ErrCanceled if the context was canceled, ErrInterrupted if
+	// the caller interrupted the operation, or ErrIncompatibleClient if the
+	// client doesn't support any version of the protocol supported here.
 	AcceptSession(ctx context.Context, interrupt chan bool) (Session, error)
 
 	// SetGUI sets the top-level primitives that define the GUI. Single-connection
@@ -58,8 +60,11 @@ type ProntoGUI interface {
 	SetGUI(primitives ...Primitive) error
 
 	// Wait sends the current GUI state to the client and blocks until the client
-	// sends back an update. Returns the Primitive that was updated, or an error
-	// if the client disconnects. Single-connection mode only.
+	// sends back an update. Returns the Primitive that was updated, or nil if the
+	// client disconnects. Returns an error wrapping ErrSessionEnded if the session
+	// failed, after which the next client may connect. Returns ErrIncompatibleClient
+	// if a client connects that doesn't support any version of the protocol
+	// supported here. Single-connection mode only.
 	Wait() (Primitive, error)
 
 	// WaitOrCancel is like Wait but also returns if the context is canceled
@@ -73,12 +78,34 @@ type ProntoGUI interface {
 	// inbound update without blocking. Returns nil if no update is available.
 	// Single-connection mode only.
 	Update() (Primitive, error)
+
+	// RunDialog shows a dialog on top of the GUI until one of its buttons is issued or the
+	// client dismisses it, and returns the button issued or nil.  See Session.RunDialog.
+	// Single-connection mode only.
+	RunDialog(ctx context.Context, dialog *Dialog) (*Command, error)
+
+	// Confirm asks the user to confirm something using a dialog with OK and Cancel buttons.
+	// Returns true if the user chose OK.  See Session.Confirm.
+	// Single-connection mode only.
+	Confirm(ctx context.Context, message string) (bool, error)
+
+	// Notify shows a transient message to the user on top of the GUI.  See Session.Notify.
+	// Single-connection mode only.
+	Notify(message string, level NotificationLevel, duration time.Duration) error
+
+	// BindKey calls handler whenever the user presses a key, such as "Ctrl+S".  Bindings are
+	// kept for clients that connect later.  See Session.BindKey.
+	// Single-connection mode only.
+	BindKey(keys string, handler func()) error
 }
 
 // Internal data for handling the API of this library
 type _ProntoGUI struct {
 	pgcomm *pgcomm.PGComm
 
+	// The options supplied when this was created.
+	opts *options
+
 	// The default session used by single-connection mode.
 	defaultSession Session
 
@@ -93,11 +120,18 @@ type _ProntoGUI struct {
 	// The current GUI primitives when operating in single session mode.
 	currentGUI []Primitive
 
+	// The current key bindings, by normalized key, when operating in single session mode.
+	currentKeyBindings map[string]func()
+
 	// Channel for delivering sessions to AcceptSession
 	sessionDelivery chan Session
 
 	// True if currently serving clients
 	isServing bool
+
+	// Hashes of the blobs cached by the App.  In single-connection mode, this carries over
+	// from one session to the next, since it's likely the same App reconnecting.
+	clientBlobs *blobHashes
 }
 
 // Deprecated: use StartServingSingle or StartServingMultiple.
@@ -136,7 +170,12 @@ func (pg *_ProntoGUI) startServing(addr string, port int, maxSessions int) error
 				return
 			}
 
-			pg.sessionDelivery <- NewSession(apicall)
+			session := newSession(apicall, pg.opts)
+			if pg.singleSessionMode {
+				session.synchro.clientBlobs = pg.clientBlobs
+			}
+
+			pg.sessionDelivery <- session
 		}
 	}()
 
@@ -167,6 +206,9 @@ func (pg *_ProntoGUI) AcceptSession(ctx context.Context, interrupt chan bool) (S
 		if !ok {
 			return nil, errors.New("server stopped")
 		}
+		if isIncompatible(session) {
+			return nil, ErrIncompatibleClient
+		}
 		return session, nil
 	case <-ctx.Done():
 		return nil, ErrCanceled
@@ -215,10 +257,17 @@ func (pg *_ProntoGUI) checkForDefaultSession(block bool, ctx context.Context, in
 		}
 	}
 
+	if isIncompatible(session) {
+		return ErrIncompatibleClient
+	}
+
 	if session != nil {
 		// Apply any buffered SetGUI call when operating in single session mode
 		if pg.singleSessionMode {
 			session.SetGUI(pg.currentGUI...)
+			for keys, handler := range pg.currentKeyBindings {
+				session.BindKey(keys, handler)
+			}
 		}
 		pg.defaultSession = session
 	}
@@ -269,10 +318,7 @@ func (pg *_ProntoGUI) Wait() (Primitive, error) {
 
 	// Exchange updates and wait until App has an update.
 	p, err := pg.defaultSession.Wait()
-	if err == ErrSessionEnded {
-		pg.defaultSession = nil
-		err = nil
-	}
+	err = pg.checkSessionEnded(err)
 	return p, err
 }
 
@@ -296,10 +342,7 @@ func (pg *_ProntoGUI) WaitOrCancel(ctx context.Context, interrupt chan bool) (Pr
 
 	// Exchange updates and wait until App has an update.
 	p, err := pg.defaultSession.WaitOrCancel(ctx, interrupt)
-	if err == ErrSessionEnded {
-		pg.defaultSession = nil
-		err = nil
-	}
+	err = pg.checkSessionEnded(err)
 	return p, err
 }
 
@@ -322,17 +365,137 @@ func (pg *_ProntoGUI) Update() (Primitive, error) {
 	}
 
 	p, err := pg.defaultSession.Update()
+	err = pg.checkSessionEnded(err)
+
+	return p, err
+}
+
+func (pg *_ProntoGUI) RunDialog(ctx context.Context, dialog *Dialog) (*Command, error) {
+	if !pg.isServing {
+		return nil, errors.New("not currently serving clients")
+	}
+
+	if !pg.singleSessionMode {
+		return nil, errors.New("RunDialog is only available when using StartServingSingle")
+	}
+
+	err := pg.checkForDefaultSession(false, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if pg.defaultSession == nil {
+		return nil, errors.New("no session in progress")
+	}
+
+	button, err := pg.defaultSession.RunDialog(ctx, dialog)
+	err = pg.checkSessionEnded(err)
+	return button, err
+}
+
+func (pg *_ProntoGUI) Confirm(ctx context.Context, message string) (bool, error) {
+	if !pg.isServing {
+		return false, errors.New("not currently serving clients")
+	}
+
+	if !pg.singleSessionMode {
+		return false, errors.New("Confirm is only available when using StartServingSingle")
+	}
+
+	err := pg.checkForDefaultSession(false, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	if pg.defaultSession == nil {
+		return false, errors.New("no session in progress")
+	}
+
+	ok, err := pg.defaultSession.Confirm(ctx, message)
+	err = pg.checkSessionEnded(err)
+	return ok, err
+}
+
+func (pg *_ProntoGUI) Notify(message string, level NotificationLevel, duration time.Duration) error {
+	if !pg.isServing {
+		return errors.New("not currently serving clients")
+	}
+
+	if !pg.singleSessionMode {
+		return errors.New("Notify is only available when using StartServingSingle")
+	}
+
+	err := pg.checkForDefaultSession(false, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if pg.defaultSession == nil {
+		return errors.New("no session in progress")
+	}
+
+	return pg.defaultSession.Notify(message, level, duration)
+}
+
+func (pg *_ProntoGUI) BindKey(keys string, handler func()) error {
+	if !pg.isServing {
+		return errors.New("not currently serving clients")
+	}
+
+	if !pg.singleSessionMode {
+		return errors.New("BindKey is only available when using StartServingSingle")
+	}
+
+	k, err := NormalizeKey(keys)
+	if err != nil {
+		return err
+	}
+
+	err = pg.checkForDefaultSession(false, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if pg.currentKeyBindings == nil {
+		pg.currentKeyBindings = map[string]func(){}
+	}
+	if handler == nil {
+		delete(pg.currentKeyBindings, k)
+	} else {
+		pg.currentKeyBindings[k] = handler
+	}
+
+	if pg.defaultSession != nil {
+		return pg.defaultSession.BindKey(k, handler)
+	}
+
+	return nil
+}
+
+// Drops the default session if err shows that it has ended, so the next client can connect.
+// Returns nil if the client simply disconnected, or err otherwise, such as when the session
+// failed.
+func (pg *_ProntoGUI) checkSessionEnded(err error) error {
+	if !errors.Is(err, ErrSessionEnded) {
+		return err
+	}
+	pg.defaultSession = nil
 	if err == ErrSessionEnded {
-		pg.defaultSession = nil
-		err = nil
+		return nil
 	}
+	return err
+}
 
-	return p, err
+// Returns true if the session's client has no protocol version in common with this library.
+func isIncompatible(session Session) bool {
+	s, ok := session.(*_Session)
+	return ok && s.incompatible
 }
 
-// NewProntoGUI creates a new ProntoGUI instance.
-func NewProntoGUI() ProntoGUI {
-	pgcomm := pgcomm.NewPGComm()
-	pg := &_ProntoGUI{pgcomm: pgcomm}
+// NewProntoGUI creates a new ProntoGUI instance.  Options can be supplied to
+// configure the underlying gRPC server, such as WithMaxMessageSize or WithLogger.
+func NewProntoGUI(opts ...Option) ProntoGUI {
+	o := newOptions(opts...)
+	pg := &_ProntoGUI{pgcomm: o.makePGComm(), opts: o, clientBlobs: newBlobHashes()}
 	return pg
 }
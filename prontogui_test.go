@@ -8,7 +8,19 @@ package golib
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"testing"
+	"time"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/prontogui/golib/key"
+	pb "github.com/prontogui/golib/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func Test_NewProntoGUI(t *testing.T) {
@@ -40,3 +52,99 @@ func Test_AcceptSession_AfterStop(t *testing.T) {
 		t.Fatal("expected error from AcceptSession after StopServing")
 	}
 }
+
+// A primitive that panics when looking for one of its descendants.
+type locatePanickingPrimitive struct {
+	SimplePrimitive
+}
+
+func (p *locatePanickingPrimitive) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	panic("locate failed")
+}
+
+// Returns a port that is free to listen on.
+func freePort(t *testing.T) int {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+// Connects a client to the server listening on port and starts streaming updates.
+func openStream(t *testing.T, port int) grpc.BidiStreamingClient[pb.PGUpdate, pb.PGUpdate] {
+	conn, err := grpc.NewClient(fmt.Sprintf("127.0.0.1:%d", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	stream, err := pb.NewPGServiceClient(conn).StreamUpdates(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stream
+}
+
+func Test_StartServingSingle_ReconnectAfterSessionFails(t *testing.T) {
+	pg := NewProntoGUI()
+	port := freePort(t)
+	if err := pg.StartServingSingle("127.0.0.1", port); err != nil {
+		t.Fatalf("StartServingSingle failed: %v", err)
+	}
+	defer pg.StopServing()
+
+	pg.SetGUI(&locatePanickingPrimitive{})
+
+	// The first client sends an update that makes the session fail, then reads until its
+	// stream ends
+	first := openStream(t, port)
+	ended := make(chan error, 1)
+	go func() {
+		first.Recv()
+		update, _ := cbor.Marshal([]any{false, []any{0, 0}, map[any]any{"Label": "x"}})
+		first.Send(&pb.PGUpdate{Cbor: update})
+		for {
+			if _, err := first.Recv(); err != nil {
+				ended <- err
+				return
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := pg.WaitOrCancel(ctx, nil)
+	if !errors.Is(err, ErrSessionEnded) || err == ErrSessionEnded {
+		t.Fatalf("expecting the session failure to be returned; got %v", err)
+	}
+
+	select {
+	case err := <-ended:
+		if status.Code(err) != codes.Aborted {
+			t.Fatalf("expecting the stream to be aborted; got %v", err)
+		}
+	case <-ctx.Done():
+		t.Fatal("the stream of the failed session did not end")
+	}
+
+	// Another client can now connect and get the GUI
+	pg.SetGUI(TextWith{Content: "hello"}.Make())
+
+	second := openStream(t, port)
+	received := make(chan bool, 1)
+	go func() {
+		_, err := second.Recv()
+		received <- err == nil
+		second.Send(&pb.PGUpdate{})
+	}()
+
+	if _, err := pg.WaitOrCancel(ctx, nil); err != nil {
+		t.Fatalf("unexpected error for the next client: %v", err)
+	}
+	if !<-received {
+		t.Fatal("the next client did not receive the GUI")
+	}
+}
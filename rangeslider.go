@@ -0,0 +1,290 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"strconv"
+
+	"github.com/prontogui/golib/key"
+)
+
+// A control for choosing a range of values, from low to high, by dragging two thumbs along a
+// track.
+type RangeSliderWith struct {
+	Embodiment  string
+	High        float64
+	Low         float64
+	Max         float64
+	Min         float64
+	Orientation string
+	Status      int
+	Step        float64
+	Tag         string
+}
+
+// Creates a new RangeSlider using the supplied field assignments.
+func (w RangeSliderWith) Make() *RangeSlider {
+	slider := &RangeSlider{}
+	slider.embodiment.Set(w.Embodiment)
+	slider.high.Set(w.High)
+	slider.low.Set(w.Low)
+	slider.max.Set(w.Max)
+	slider.min.Set(w.Min)
+	slider.orientation.Set(w.Orientation)
+	slider.status.Set(w.Status)
+	slider.step.Set(w.Step)
+	slider.tag.Set(w.Tag)
+	return slider
+}
+
+// A control for choosing a range of values, from low to high, by dragging two thumbs along a
+// track.
+//
+// While the user drags a thumb, the App sends the changing range and Changing returns true.
+// When the user releases the thumb, Committed returns true.
+type RangeSlider struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	changing    EventField
+	committed   EventField
+	embodiment  StringField
+	high        FloatField
+	low         FloatField
+	max         FloatField
+	min         FloatField
+	orientation StringField
+	status      IntegerField
+	step        FloatField
+	tag         StringField
+}
+
+// Creates a new RangeSlider with a range of values that is initially all chosen.
+func NewRangeSlider(min, max float64) *RangeSlider {
+	return RangeSliderWith{Min: min, Max: max, Low: min, High: max}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (slider *RangeSlider) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	slider.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Changing, &slider.changing},
+			{key.FKey_Committed, &slider.committed},
+			{key.FKey_Embodiment, &slider.embodiment},
+			{key.FKey_High, &slider.high},
+			{key.FKey_Low, &slider.low},
+			{key.FKey_MaxValue, &slider.max},
+			{key.FKey_MinValue, &slider.min},
+			{key.FKey_Orientation, &slider.orientation},
+			{key.FKey_Status, &slider.status},
+			{key.FKey_Step, &slider.step},
+			{key.FKey_Tag, &slider.tag},
+		}
+	})
+}
+
+// Ingests an update from the app.  A low or high value outside of the range is clamped to the
+// range, and a value that is not a number is replaced by the previous value.  A low value moved
+// above the high value is lowered to the high value, and a high value moved below the low value
+// is raised to the low value.  The corrected values are sent back to the app.  This is used
+// internally by this library and normally should not be called by users of the library.
+func (slider *RangeSlider) IngestUpdate(update map[any]any) error {
+	previousLow, previousHigh := slider.low.Get(), slider.high.Get()
+	if err := slider.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+
+	low := clampSliderValue(slider.low.Get(), previousLow, slider.min.Get(), slider.max.Get())
+	high := clampSliderValue(slider.high.Get(), previousHigh, slider.min.Get(), slider.max.Get())
+	if low > high {
+		if low != previousLow {
+			low = high
+		} else {
+			high = low
+		}
+	}
+	slider.low.Set(low)
+	slider.high.Set(high)
+	return nil
+}
+
+// Returns a string representation of this primitive:  the low and high values.
+// Implements of fmt:Stringer interface.
+func (slider *RangeSlider) String() string {
+	return strconv.FormatFloat(slider.low.Get(), 'f', -1, 64) + " - " + strconv.FormatFloat(slider.high.Get(), 'f', -1, 64)
+}
+
+// Returns the low value of the range chosen by the user.
+func (slider *RangeSlider) Low() float64 {
+	return slider.low.Get()
+}
+
+// Sets the low value of the range chosen by the user.
+func (slider *RangeSlider) SetLow(v float64) *RangeSlider {
+	slider.low.Set(v)
+	return slider
+}
+
+// Returns the high value of the range chosen by the user.
+func (slider *RangeSlider) High() float64 {
+	return slider.high.Get()
+}
+
+// Sets the high value of the range chosen by the user.
+func (slider *RangeSlider) SetHigh(v float64) *RangeSlider {
+	slider.high.Set(v)
+	return slider
+}
+
+// Returns the smallest value of the range.
+func (slider *RangeSlider) Min() float64 {
+	return slider.min.Get()
+}
+
+// Sets the smallest value of the range.
+func (slider *RangeSlider) SetMin(v float64) *RangeSlider {
+	slider.min.Set(v)
+	return slider
+}
+
+// Returns the largest value of the range.
+func (slider *RangeSlider) Max() float64 {
+	return slider.max.Get()
+}
+
+// Sets the largest value of the range.
+func (slider *RangeSlider) SetMax(v float64) *RangeSlider {
+	slider.max.Set(v)
+	return slider
+}
+
+// Returns the amount the value changes by at each step along the track, or 0 if the value
+// changes continuously.
+func (slider *RangeSlider) Step() float64 {
+	return slider.step.Get()
+}
+
+// Sets the amount the value changes by at each step along the track, or 0 if the value
+// changes continuously.
+func (slider *RangeSlider) SetStep(v float64) *RangeSlider {
+	slider.step.Set(v)
+	return slider
+}
+
+// Returns the orientation of the track:  OrientationHorizontal, OrientationVertical, or empty
+// for the App's default.
+func (slider *RangeSlider) Orientation() string {
+	return slider.orientation.Get()
+}
+
+// Sets the orientation of the track:  OrientationHorizontal, OrientationVertical, or empty
+// for the App's default.
+func (slider *RangeSlider) SetOrientation(s string) *RangeSlider {
+	slider.orientation.Set(s)
+	return slider
+}
+
+// Returns true if the user changed the range by dragging a thumb during the current Wait
+// cycle.  The range is still changing until Committed returns true.
+func (slider *RangeSlider) Changing() bool {
+	return slider.changing.Issued()
+}
+
+// Returns true if the user released a thumb, settling on a range, during the current Wait
+// cycle.
+func (slider *RangeSlider) Committed() bool {
+	return slider.committed.Issued()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (slider *RangeSlider) Embodiment() string {
+	return slider.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (slider *RangeSlider) SetEmbodiment(s string) *RangeSlider {
+	slider.embodiment.Set(s)
+	return slider
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using RangeSliders as Table cells.
+func (slider *RangeSlider) Tag() string {
+	return slider.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using RangeSliders as Table cells.
+func (slider *RangeSlider) SetTag(s string) *RangeSlider {
+	slider.tag.Set(s)
+	return slider
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *RangeSlider) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *RangeSlider) SetStatus(i int) *RangeSlider {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *RangeSlider) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *RangeSlider) SetVisible(visible bool) *RangeSlider {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *RangeSlider) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *RangeSlider) SetEnabled(enabled bool) *RangeSlider {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *RangeSlider) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *RangeSlider) SetCollapsed(collapsed bool) *RangeSlider {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
@@ -0,0 +1,63 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_RangeSliderAttach(t *testing.T) {
+	slider := &RangeSlider{}
+	slider.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, slider.PrimitiveBase, "Changing", "Committed", "Embodiment", "High", "Low", "MaxValue", "MinValue", "Orientation", "Status", "Step", "Tag")
+}
+
+func Test_RangeSliderNew(t *testing.T) {
+	slider := NewRangeSlider(10, 20)
+
+	if slider.Low() != 10 || slider.High() != 20 || slider.Min() != 10 || slider.Max() != 20 {
+		t.Error("the whole range is not initially chosen")
+	}
+	if slider.String() != "10 - 20" {
+		t.Errorf("string is %q", slider.String())
+	}
+}
+
+func Test_RangeSliderIngest(t *testing.T) {
+	ts := time.Now()
+	slider := NewRangeSlider(0, 100)
+	slider.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if err := slider.IngestUpdate(map[any]any{"Low": 20.0, "High": 80.0, "Committed": true}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Low() != 20 || slider.High() != 80 || !slider.Committed() {
+		t.Error("range was not ingested")
+	}
+
+	if err := slider.IngestUpdate(map[any]any{"Low": 90.0}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Low() != 80 || slider.High() != 80 {
+		t.Errorf("low value above the high value was not lowered.  Range is %v", slider)
+	}
+	if err := slider.IngestUpdate(map[any]any{"High": 120.0}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Low() != 80 || slider.High() != 100 {
+		t.Errorf("high value outside of the range was not clamped.  Range is %v", slider)
+	}
+	if err := slider.IngestUpdate(map[any]any{"High": 50.0}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Low() != 80 || slider.High() != 80 {
+		t.Errorf("high value below the low value was not raised.  Range is %v", slider)
+	}
+}
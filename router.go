@@ -0,0 +1,311 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Parameters given to a page when navigating to it, such as the ID of a record to show.
+type Params map[string]string
+
+// How a Router shows its navigation bar.
+type NavigationStyle int
+
+const (
+	// Shows the pages visited to reach the current page, each of which can be clicked to
+	// go back to it.
+	NavigationBreadcrumbs NavigationStyle = iota
+
+	// Shows every page, each of which can be clicked to go to it.
+	NavigationTabs
+
+	// Doesn't show a navigation bar.
+	NavigationNone
+)
+
+// A page of a Router.
+type routerPage struct {
+	name  string
+	title string
+
+	// Builds the primitives of the page, or nil if they are given up front.
+	build func(params Params) []Primitive
+
+	// Shows the primitives of the page.  It is only showing for the current page.
+	frame *Frame
+
+	// True once the primitives of the page have been built.
+	built bool
+
+	// The parameters the page was last built or navigated with.
+	params Params
+
+	// The tab for the page, when using NavigationTabs.
+	tab *Command
+}
+
+// A page visited by a Router.
+type routerVisit struct {
+	page   *routerPage
+	params Params
+}
+
+// A Router switches between named pages within a GUI, keeping a history for going back.  Each
+// page is kept in its own Frame once it has been shown, so switching pages only sends what
+// changed to the App, and the state of hidden pages is kept.
+//
+// Put the primitive returned by Primitive in the GUI, and pass each primitive updated by
+// Wait to Handle so the navigation bar works.
+type Router struct {
+	root       *Group
+	navBar     *Group
+	pageFrames *Group
+
+	pages     []*routerPage
+	pageNamed map[string]*routerPage
+
+	history []routerVisit
+
+	// The breadcrumbs shown in the navigation bar, one for each visit in history.
+	crumbs []*Command
+
+	style NavigationStyle
+}
+
+// Creates a new Router with no pages, which uses breadcrumbs for its navigation bar.
+func NewRouter() *Router {
+	r := &Router{
+		navBar:     NewGroup(),
+		pageFrames: NewGroup(),
+		pageNamed:  map[string]*routerPage{},
+	}
+	r.root = NewGroup(r.navBar, r.pageFrames)
+	return r
+}
+
+// Returns the primitive to put in the GUI, which holds the navigation bar followed by the
+// pages.
+func (r *Router) Primitive() Primitive {
+	return r.root
+}
+
+// Returns the Group holding the navigation bar, such as for setting its embodiment.
+func (r *Router) NavigationBar() *Group {
+	return r.navBar
+}
+
+// Adds a page made of a fixed set of primitives.  The first page added is shown until the
+// Router is navigated elsewhere.
+func (r *Router) AddPage(name, title string, items ...Primitive) *Router {
+	page := r.addPage(name, title, nil)
+	page.frame.SetFrameItems(items)
+	page.built = true
+	return r
+}
+
+// Adds a page whose primitives are built by a function when the page is first shown, and
+// again whenever it is navigated to with different parameters.  The first page added is shown
+// until the Router is navigated elsewhere.
+func (r *Router) AddPageFunc(name, title string, build func(params Params) []Primitive) *Router {
+	r.addPage(name, title, build)
+	return r
+}
+
+func (r *Router) addPage(name, title string, build func(params Params) []Primitive) *routerPage {
+	page := &routerPage{
+		name:  name,
+		title: title,
+		build: build,
+		frame: FrameWith{Tag: name, Title: title}.Make(),
+		tab:   NewCommand(title),
+	}
+
+	if old, ok := r.pageNamed[name]; ok {
+		// Replace the page of the same name
+		for i, p := range r.pages {
+			if p == old {
+				r.pages[i] = page
+			}
+		}
+		for i := range r.history {
+			if r.history[i].page == old {
+				r.history[i].page = page
+			}
+		}
+	} else {
+		r.pages = append(r.pages, page)
+	}
+	r.pageNamed[name] = page
+
+	frames := make([]Primitive, len(r.pages))
+	for i, p := range r.pages {
+		frames[i] = p.frame
+	}
+	r.pageFrames.SetGroupItems(frames)
+
+	if len(r.history) == 0 || r.history[len(r.history)-1].page == page {
+		r.show(routerVisit{page: page})
+		if len(r.history) == 0 {
+			r.history = append(r.history, routerVisit{page: page})
+		}
+	}
+	r.updateNavBar()
+
+	return page
+}
+
+// Navigates to a page, which is added to the history.  The page is built, if needed, with
+// the given parameters.  Nil parameters show the page as it was last shown.  Returns an error
+// if there is no page with the name.
+func (r *Router) Navigate(name string, params Params) error {
+	page, ok := r.pageNamed[name]
+	if !ok {
+		return fmt.Errorf("no page named %q", name)
+	}
+
+	visit := routerVisit{page: page, params: params}
+	if params == nil {
+		visit.params = page.params
+	}
+
+	current := r.history[len(r.history)-1]
+	if current.page == page && maps.Equal(current.params, visit.params) {
+		return nil
+	}
+
+	r.history = append(r.history, visit)
+	r.show(visit)
+	r.updateNavBar()
+	return nil
+}
+
+// Goes back to the previous page in the history.  Returns false if there is no previous page.
+func (r *Router) Back() bool {
+	return r.backTo(len(r.history) - 2)
+}
+
+// Returns true if there is a previous page to go back to.
+func (r *Router) CanGoBack() bool {
+	return len(r.history) > 1
+}
+
+// Returns the name of the current page, or empty if there are no pages.
+func (r *Router) Current() string {
+	if len(r.history) == 0 {
+		return ""
+	}
+	return r.history[len(r.history)-1].page.name
+}
+
+// Returns the parameters of the current page.
+func (r *Router) Params() Params {
+	if len(r.history) == 0 {
+		return nil
+	}
+	return r.history[len(r.history)-1].params
+}
+
+// Returns how the navigation bar is shown.
+func (r *Router) NavigationStyle() NavigationStyle {
+	return r.style
+}
+
+// Sets how the navigation bar is shown.
+func (r *Router) SetNavigationStyle(style NavigationStyle) *Router {
+	r.style = style
+	r.updateNavBar()
+	return r
+}
+
+// Handles a primitive updated by Wait, navigating if it was issued from the navigation bar.
+// Returns true if the update was handled by the Router.
+func (r *Router) Handle(updated Primitive) bool {
+	cmd, ok := updated.(*Command)
+	if !ok || !cmd.Issued() {
+		return false
+	}
+
+	for i, crumb := range r.crumbs {
+		if crumb == cmd {
+			r.backTo(i)
+			return true
+		}
+	}
+
+	for _, page := range r.pages {
+		if page.tab == cmd {
+			r.Navigate(page.name, nil)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Goes back to the visit at index i of the history, forgetting the visits after it.
+func (r *Router) backTo(i int) bool {
+	if i < 0 || i >= len(r.history)-1 {
+		return false
+	}
+	r.history = r.history[:i+1]
+	r.show(r.history[i])
+	r.updateNavBar()
+	return true
+}
+
+// Shows the page of a visit, building it if needed, and hides the others.
+func (r *Router) show(visit routerVisit) {
+	page := visit.page
+
+	if page.build != nil && (!page.built || !maps.Equal(page.params, visit.params)) {
+		page.frame.SetFrameItems(page.build(visit.params))
+		page.built = true
+	}
+	page.params = visit.params
+
+	for _, p := range r.pages {
+		p.frame.SetShowing(p == page)
+	}
+}
+
+// Updates the commands in the navigation bar.  The command for the current page is disabled.
+func (r *Router) updateNavBar() {
+	if len(r.history) == 0 {
+		return
+	}
+	current := r.history[len(r.history)-1].page
+
+	var items []Primitive
+
+	switch r.style {
+	case NavigationBreadcrumbs:
+		// Keep the breadcrumbs that are still valid so only new ones are sent
+		r.crumbs = r.crumbs[:min(len(r.crumbs), len(r.history))]
+		for i, visit := range r.history {
+			if i < len(r.crumbs) && r.crumbs[i].Label() == visit.page.title {
+				continue
+			}
+			r.crumbs = append(r.crumbs[:i], NewCommand(visit.page.title))
+		}
+		for i, crumb := range r.crumbs {
+			crumb.SetEnabled(i < len(r.crumbs)-1)
+			items = append(items, crumb)
+		}
+	case NavigationTabs:
+		for _, page := range r.pages {
+			page.tab.SetEnabled(page != current)
+			items = append(items, page.tab)
+		}
+	}
+
+	if !slices.Equal(items, r.navBar.GroupItems()) {
+		r.navBar.SetGroupItems(items)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// Returns the frame showing for each page of a router.
+func showingPages(r *Router) []bool {
+	var showing []bool
+	for _, page := range r.pages {
+		showing = append(showing, page.frame.Showing())
+	}
+	return showing
+}
+
+func Test_RouterNavigate(t *testing.T) {
+	home := NewText("home")
+	builds := 0
+
+	r := NewRouter().
+		AddPage("home", "Home", home).
+		AddPageFunc("record", "Record", func(params Params) []Primitive {
+			builds++
+			return []Primitive{NewText("record " + params["id"])}
+		})
+
+	if r.Current() != "home" || !r.pages[0].frame.Showing() {
+		t.Fatal("first page is not shown")
+	}
+	if builds != 0 {
+		t.Fatal("page was built before it was shown")
+	}
+
+	if err := r.Navigate("record", Params{"id": "7"}); err != nil {
+		t.Fatal(err)
+	}
+	if r.Current() != "record" || r.Params()["id"] != "7" || builds != 1 {
+		t.Fatal("unable to navigate to the record page")
+	}
+	if showing := showingPages(r); showing[0] || !showing[1] {
+		t.Errorf("pages showing are %v", showing)
+	}
+	if r.pages[1].frame.FrameItems()[0].String() != "record 7" {
+		t.Error("page was not built with its parameters")
+	}
+
+	if err := r.Navigate("missing", nil); err == nil {
+		t.Error("expecting an error for a page that doesn't exist")
+	}
+}
+
+func Test_RouterBackKeepsState(t *testing.T) {
+	field := NewTextField("")
+	builds := 0
+
+	r := NewRouter().
+		AddPage("form", "Form", field).
+		AddPageFunc("record", "Record", func(params Params) []Primitive {
+			builds++
+			return []Primitive{NewText(params["id"])}
+		})
+
+	field.SetTextEntry("typed by the user")
+	r.Navigate("record", Params{"id": "1"})
+	r.Navigate("record", Params{"id": "2"})
+	r.Navigate("form", nil)
+	r.Navigate("record", nil)
+
+	if builds != 2 {
+		t.Errorf("page was built %d times.  Expecting 2", builds)
+	}
+
+	// Back through record 2, form, record 2, record 1
+	for _, expected := range []string{"form", "record", "record", "form"} {
+		if !r.Back() {
+			t.Fatal("unable to go back")
+		}
+		if r.Current() != expected {
+			t.Fatalf("current page is %q.  Expecting %q", r.Current(), expected)
+		}
+	}
+
+	if r.Back() || r.CanGoBack() {
+		t.Error("able to go back past the first page")
+	}
+	if field.TextEntry() != "typed by the user" {
+		t.Error("state of the hidden page was lost")
+	}
+	if builds != 3 {
+		t.Errorf("page was built %d times.  Expecting 3, to go back to record 1", builds)
+	}
+}
+
+func Test_RouterBreadcrumbs(t *testing.T) {
+	ts := time.Now()
+	r := NewRouter().AddPage("a", "A").AddPage("b", "B").AddPage("c", "C")
+	r.Primitive().PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	r.Navigate("b", nil)
+	r.Navigate("c", nil)
+
+	crumbs := r.NavigationBar().GroupItems()
+	if len(crumbs) != 3 || crumbs[0].String() != "A" || crumbs[2].String() != "C" {
+		t.Fatalf("breadcrumbs are %v", crumbs)
+	}
+	if crumbs[2].(*Command).Enabled() {
+		t.Error("breadcrumb for the current page is enabled")
+	}
+
+	// Click the first breadcrumb
+	crumbs[0].IngestUpdate(map[any]any{"CommandIssued": true})
+	if !r.Handle(crumbs[0]) {
+		t.Fatal("breadcrumb was not handled")
+	}
+	if r.Current() != "a" || len(r.NavigationBar().GroupItems()) != 1 {
+		t.Error("unable to go back using a breadcrumb")
+	}
+
+	if r.Handle(NewText("other")) {
+		t.Error("handled an update that isn't from the navigation bar")
+	}
+}
+
+func Test_RouterTabs(t *testing.T) {
+	ts := time.Now()
+	r := NewRouter().AddPage("a", "A").AddPage("b", "B").SetNavigationStyle(NavigationTabs)
+	r.Primitive().PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	tabs := r.NavigationBar().GroupItems()
+	if len(tabs) != 2 {
+		t.Fatalf("tabs are %v", tabs)
+	}
+
+	tabs[1].IngestUpdate(map[any]any{"CommandIssued": true})
+	if !r.Handle(tabs[1]) || r.Current() != "b" {
+		t.Fatal("unable to navigate using a tab")
+	}
+	if tabs[1].(*Command).Enabled() || !tabs[0].(*Command).Enabled() {
+		t.Error("only the tab for the current page should be disabled")
+	}
+
+	r.SetNavigationStyle(NavigationNone)
+	if len(r.NavigationBar().GroupItems()) != 0 {
+		t.Error("navigation bar is not empty")
+	}
+}
+
+func Test_RouterSendsOnlyChanges(t *testing.T) {
+	r := NewRouter().AddPage("a", "A", NewText("a")).AddPage("b", "B", NewText("b")).SetNavigationStyle(NavigationTabs)
+
+	synchro := NewSynchro()
+	synchro.SetTopPrimitives(getBogeyEventTimestampProvider(), r.Primitive())
+	synchro.GetFullUpdate()
+
+	r.Navigate("b", nil)
+
+	if len(synchro.pendingUpdates) == 0 {
+		t.Fatal("no updates are pending")
+	}
+	for _, update := range synchro.pendingUpdates {
+		for _, fkey := range update.fields {
+			if fkey != key.FKey_Showing && fkey != key.FKey_Status {
+				t.Errorf("field %s updated at pkey %v.  Expecting only Showing and Status", key.FieldnameFor(fkey), update.pkey)
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A chart showing each point of each series as a marker, such as to compare two measurements.
+type ScatterChartWith struct {
+	Embodiment    string
+	Legend        bool
+	ScatterSeries []*ChartSeries
+	Status        int
+	Tag           string
+	Title         string
+	WindowSize    int
+	XAxis         *Axis
+	YAxis         *Axis
+}
+
+// Makes a new ScatterChart with specified field values.
+func (w ScatterChartWith) Make() *ScatterChart {
+	chart := &ScatterChart{}
+	chart.embodiment.Set(w.Embodiment)
+	chart.legend.Set(w.Legend)
+	chart.setWindowSize(w.WindowSize)
+	chart.setChartSeries(w.ScatterSeries)
+	chart.status.Set(w.Status)
+	chart.tag.Set(w.Tag)
+	chart.title.Set(w.Title)
+	chart.SetXAxis(w.XAxis)
+	chart.SetYAxis(w.YAxis)
+	return chart
+}
+
+// A chart showing each point of each series as a marker, such as to compare two measurements.
+// The label of each series is shown in the legend, if enabled.
+//
+// Points can be streamed to the App using AddPoint, which only sends the new points to Apps
+// that support it.  Set a window size to keep only the most recent points of each series, such
+// as for a live dashboard.
+type ScatterChart struct {
+	// Mix-in the guts shared by charts
+	chartBase
+}
+
+// Creates a new ScatterChart with a title and its series.
+func NewScatterChart(title string, series ...*ChartSeries) *ScatterChart {
+	return ScatterChartWith{Title: title, ScatterSeries: series}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (chart *ScatterChart) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+	chart.prepareForUpdates(key.FKey_ScatterSeries, pkey, onset, etsprovider)
+}
+
+// Returns the series shown in the chart.
+func (chart *ScatterChart) ScatterSeries() []*ChartSeries {
+	return chart.chartSeries()
+}
+
+// Sets the series shown in the chart.  The window size of the chart is applied to each series.
+func (chart *ScatterChart) SetScatterSeries(series []*ChartSeries) *ScatterChart {
+	chart.setChartSeries(series)
+	return chart
+}
+
+// Sets the series (variadic argument list) shown in the chart.  The window size of the chart is
+// applied to each series.
+func (chart *ScatterChart) SetScatterSeriesVA(series ...*ChartSeries) *ScatterChart {
+	return chart.SetScatterSeries(series)
+}
+
+// Sets the maximum number of points kept in each series, or 0 for no maximum.  The oldest
+// points are dropped once there are more.  A negative size is taken as 0.
+func (chart *ScatterChart) SetWindowSize(n int) *ScatterChart {
+	chart.setWindowSize(n)
+	return chart
+}
+
+// Sets the title shown above the chart.
+func (chart *ScatterChart) SetTitle(s string) *ScatterChart {
+	chart.title.Set(s)
+	return chart
+}
+
+// Sets whether a legend with the label of each series is shown.
+func (chart *ScatterChart) SetLegend(b bool) *ScatterChart {
+	chart.legend.Set(b)
+	return chart
+}
+
+// Sets the X axis, or nil to let the App decide how to show it.
+func (chart *ScatterChart) SetXAxis(axis *Axis) *ScatterChart {
+	chart.xAxis.Set(axisPrimitive(axis))
+	return chart
+}
+
+// Sets the Y axis, or nil to let the App decide how to show it.
+func (chart *ScatterChart) SetYAxis(axis *Axis) *ScatterChart {
+	chart.yAxis.Set(axisPrimitive(axis))
+	return chart
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (chart *ScatterChart) SetEmbodiment(s string) *ScatterChart {
+	chart.embodiment.Set(s)
+	return chart
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (chart *ScatterChart) SetTag(s string) *ScatterChart {
+	chart.tag.Set(s)
+	return chart
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *ScatterChart) SetStatus(i int) *ScatterChart {
+	p.status.Set(i)
+	return p
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *ScatterChart) SetVisible(visible bool) *ScatterChart {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *ScatterChart) SetEnabled(enabled bool) *ScatterChart {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *ScatterChart) SetCollapsed(collapsed bool) *ScatterChart {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
@@ -9,13 +9,20 @@ package golib
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"runtime/debug"
+	"slices"
 	"time"
 
 	"github.com/prontogui/golib/pgcomm"
 )
 
 // Defined error indicating the session ended, typically when a client disconnects.
-// This can be returned from Wait, WaitOrCancel, or Update functions.
+// This can be returned from Wait, WaitOrCancel, or Update functions.  It is also wrapped
+// by the error returned when a session fails, such as from a panic in a primitive, which
+// ends that session only and disconnects its client.
 var ErrSessionEnded = errors.New("session ended")
 
 // Defined error indicating that the operation was canceled by way
@@ -23,11 +30,23 @@ var ErrSessionEnded = errors.New("session ended")
 // WaitOrCancel or AcceptSession functions.
 var ErrCanceled = errors.New("operation canceled by provided context")
 
+// Defined error indicating that the client doesn't support any version of the protocol
+// supported by this library.  This can be returned from AcceptSession, Wait, WaitOrCancel,
+// or Update functions.
+var ErrIncompatibleClient = errors.New("client is incompatible with this version of the library")
+
 // Defined error indicating that the operation was interruptred by the
 // caller using the interrupt channel argument.
 var ErrInterrupted = errors.New("operation was interrupted by the caller")
 
+// Defined error indicating that the client doesn't support a primitive needed for the
+// operation.  This can be returned from RunDialog or Confirm functions.
+var ErrNotSupported = errors.New("operation is not supported by the client")
+
 // Session represents a single client connection with its own GUI lifecycle.
+//
+// Sessions are only created by this package, such as by AcceptSession.  The interface is not
+// meant to be implemented elsewhere, and methods are added to it as the library grows.
 type Session interface {
 	// SetGUI sets the top-level primitives that define the GUI.
 	SetGUI(primitives ...Primitive)
@@ -45,6 +64,62 @@ type Session interface {
 	// Update sends the current GUI state to the client and checks for an
 	// inbound update without blocking. Returns nil if no update is available.
 	Update() (Primitive, error)
+
+	// SetFlushPolicy sets how often Update sends the current GUI state to the client.
+	SetFlushPolicy(policy FlushPolicy)
+
+	// SetIngestErrorHandler sets a function to call when an update from the client can't
+	// be ingested.  When set, Wait and WaitOrCancel keep waiting, and Update returns nil,
+	// rather than returning the error.
+	SetIngestErrorHandler(handler func(err *IngestError))
+
+	// ClientAddr returns the network address of the client, or empty if not known.
+	ClientAddr() string
+
+	// RunDialog shows a dialog on top of the GUI and handles updates from the client until
+	// one of the dialog's buttons is issued or the client dismisses the dialog.  Returns
+	// the button issued, or nil if dismissed.  The dialog is then hidden with the next
+	// update sent to the client, leaving the GUI as it was.  Updates to other primitives
+	// while the dialog is showing are ingested but not returned.  Returns ErrCanceled if
+	// the context is canceled first.  The dialog must not be part of the GUI given to SetGUI,
+	// since it is shown on top of the GUI rather than in place.
+	RunDialog(ctx context.Context, dialog *Dialog) (*Command, error)
+
+	// Confirm asks the user to confirm something using a dialog with OK and Cancel buttons,
+	// like RunDialog.  Returns true if the user chose OK.
+	Confirm(ctx context.Context, message string) (bool, error)
+
+	// Notify shows a transient message to the user, such as "Saved", on top of the GUI for
+	// the given duration, or DefaultNotificationDuration if 0.  The message is sent with the
+	// next update and doesn't change the GUI or the pkeys of its primitives.  Returns
+	// ErrNotSupported if the client doesn't support notifications.
+	Notify(message string, level NotificationLevel, duration time.Duration) error
+
+	// BindKey calls handler whenever the user presses a key, such as "Ctrl+S", while the App
+	// has focus.  The handler is called from within Wait, WaitOrCancel, or Update, which keep
+	// waiting or return nil rather than returning the key press.  A nil handler removes the
+	// binding.  See NormalizeKey for how keys are named.  Returns an error if the key can't
+	// be named, or ErrNotSupported if the client doesn't support key events.
+	BindKey(keys string, handler func()) error
+}
+
+// FlushPolicy controls how often Update sends changes to the client.  Changes that are
+// held back remain pending and are coalesced into the next update, where only the latest
+// value of each field is sent.  Wait and WaitOrCancel always send changes right away.
+type FlushPolicy struct {
+	// The minimum time between updates sent by Update.  When this is greater than zero,
+	// Update also holds back changes instead of blocking when the connection is busy.
+	// Zero sends changes on every call to Update.
+	MinInterval time.Duration
+}
+
+// Returns a FlushPolicy that limits Update to sending at most the given number of updates
+// per second.
+func MaxUpdateRate(updatesPerSecond int) FlushPolicy {
+	if updatesPerSecond <= 0 {
+		return FlushPolicy{}
+	}
+	return FlushPolicy{MinInterval: time.Second / time.Duration(updatesPerSecond)}
 }
 
 type _Session struct {
@@ -53,23 +128,162 @@ type _Session struct {
 	isgui          bool
 	fullupdate     bool
 	eventTimestamp time.Time
+
+	// An update that couldn't be sent during the last exchange with the client.
+	unsentUpdate []byte
+
+	flushPolicy FlushPolicy
+	lastFlush   time.Time
+
+	// True if the client has no protocol version in common with this library.
+	incompatible bool
+
+	// The error that ended the session after a failure, such as a panic in a primitive.
+	failure error
+
+	// True while calling a handler given by the application, such as one bound by BindKey.
+	// A panic in the handler is not taken as a failure of the session.
+	callingHandler bool
+
+	logger *slog.Logger
+
+	ingestErrorHandler func(err *IngestError)
+
+	// The top-level primitives given to SetGUI.
+	gui []Primitive
+
+	// Top-level primitives shown on top of the GUI, such as dialogs.  These follow the
+	// primitives of the GUI so their pkeys are left alone.
+	overlays []Primitive
+
+	// The dialog used by Confirm, once needed.
+	confirmDialog *Dialog
+
+	// Where notifications are shown, once needed.
+	notificationArea *NotificationArea
+
+	// Receives the keys bound by BindKey, once needed.
+	keyEvent *KeyEvent
+
+	// The handlers for keys bound by BindKey, by normalized key.
+	keyHandlers map[string]func()
+
+	// A primitive updated by the client that is held back by its debounce delay, and when to
+	// return it from Wait.
+	debounced     debouncer
+	debounceUntil time.Time
+
+	// Fires at debounceUntil while a primitive is held back, once needed.
+	debounceTimer *time.Timer
+}
+
+// A primitive whose updates from the client can be held back for a while, so Wait isn't woken
+// by each one, such as a TextField while the user is typing.
+type debouncer interface {
+	Primitive
+
+	// Returns how long to hold back the update just ingested, or 0 to return it right away.
+	debounceDelay() time.Duration
+
+	// Issues the update held back for the current Wait cycle.
+	reissue()
 }
 
 // NewSession creates a new Session bound to the given streaming API call.
 func NewSession(apicall *pgcomm.StreamingAPICall) Session {
-	return &_Session{
-		synchro:    NewSynchro(),
-		apicall:    apicall,
-		isgui:      false,
-		fullupdate: true,
+	return newSession(apicall, newOptions())
+}
+
+// Creates a new session configured by the options given to NewProntoGUI.
+func newSession(apicall *pgcomm.StreamingAPICall, o *options) *_Session {
+	s := &_Session{
+		synchro:     NewSynchro(),
+		apicall:     apicall,
+		isgui:       false,
+		fullupdate:  true,
+		flushPolicy: o.flushPolicy,
+		logger:      o.logger,
 	}
+
+	if s.logger == nil {
+		s.logger = slog.Default()
+	}
+
+	blobChunkSize := DefaultBlobChunkSize
+	if o.blobChunkSize > 0 {
+		blobChunkSize = o.blobChunkSize
+	}
+
+	s.applyHandshake(apicall, blobChunkSize)
+
+	if o.ingestErrorHandler != nil {
+		s.ingestErrorHandler = func(err *IngestError) {
+			o.ingestErrorHandler(s, err)
+		}
+	}
+
+	return s
 }
 
 // SetGUI sets the top-level primitives that define the GUI.
 func (s *_Session) SetGUI(primitives ...Primitive) {
+	s.gui = primitives
+	s.clearDebounced()
+	s.setTopPrimitives()
+}
+
+// Sets the primitives of the GUI followed by any overlays as the top-level primitives, which
+// are then sent to the client as a full update.
+func (s *_Session) setTopPrimitives() {
 	s.fullupdate = true
 	s.isgui = true
-	s.synchro.SetTopPrimitives(s.getEventTimestamp, primitives...)
+	s.unsentUpdate = nil
+
+	top := make([]Primitive, 0, len(s.gui)+len(s.overlays))
+	top = append(top, s.gui...)
+	top = append(top, s.overlays...)
+	s.synchro.SetTopPrimitives(s.getEventTimestamp, top...)
+}
+
+// Adds a primitive to show on top of the GUI, if it isn't already.  Overlays are kept when
+// the GUI is changed, so they only need to be sent once.  Adding one changes the top-level
+// primitives, which takes a full update, so an overlay added before the GUI is set waits to
+// be sent along with it.
+func (s *_Session) addOverlay(p Primitive) {
+	if slices.Contains(s.overlays, p) {
+		return
+	}
+	s.overlays = append(s.overlays, p)
+	if s.isgui {
+		s.setTopPrimitives()
+	}
+}
+
+// Returns true if p is one of the primitives given to SetGUI or one of their descendants.
+func (s *_Session) inGUI(p Primitive) bool {
+	found := false
+	for _, top := range s.gui {
+		walkPrimitives(top, func(q Primitive) {
+			found = found || q == p
+		})
+	}
+	return found
+}
+
+// SetFlushPolicy sets how often Update sends the current GUI state to the client.
+func (s *_Session) SetFlushPolicy(policy FlushPolicy) {
+	s.flushPolicy = policy
+}
+
+// SetIngestErrorHandler sets a function to call when an update from the client can't be
+// ingested, instead of returning the error.
+func (s *_Session) SetIngestErrorHandler(handler func(err *IngestError)) {
+	s.ingestErrorHandler = handler
+}
+
+// ClientAddr returns the network address of the client, or empty if not known.
+func (s *_Session) ClientAddr() string {
+	return s.apicall.PeerAddr
 }
 
 func (s *_Session) getEventTimestamp() time.Time {
@@ -84,121 +298,276 @@ func (s *_Session) updateEventTimestamp() {
 // sends back an update. Returns the Primitive that was updated, or an error
 // if unsuccessful such as ErrSessionDisconnected or ErrServingStopped.
 func (s *_Session) Wait() (Primitive, error) {
-	updateOut, err := s.getNextUpdate()
-	if err != nil {
-		return nil, err
+	return s.exchangeUpdates(nil, nil, true)
+}
+
+// WaitOrCancel is like Wait but also returns ErrCanceled if the provided
+// context is canceled before an update arrives from the client.
+func (s *_Session) WaitOrCancel(ctx context.Context, interrupt chan bool) (Primitive, error) {
+	return s.exchangeUpdates(ctx.Done(), interrupt, true)
+}
+
+// Update sends the current GUI state to the client and checks for an
+// inbound update without blocking. Returns the primitive that was updated or
+// nil if no update is available. Returns an error if unsuccessful such as
+// ErrSessionDisconnected or ErrServingStopped.
+func (s *_Session) Update() (Primitive, error) {
+	return s.exchangeUpdates(nil, nil, false)
+}
+
+// Returns true if the current GUI state should be sent to the client now, according to
+// the flush policy.
+func (s *_Session) isFlushDue(block bool) bool {
+	if block || s.flushPolicy.MinInterval <= 0 {
+		return true
 	}
 
-	// Send update to the client
-	select {
-	case s.apicall.Outbound <- updateOut:
-		break
-	case <-s.apicall.CallHasExited:
-		return nil, ErrSessionEnded
+	if time.Since(s.lastFlush) < s.flushPolicy.MinInterval {
+		return false
 	}
 
-	// Wait for inbound update from client.
-	select {
-	case updateIn, ok := <-s.apicall.Inbound:
-		if !ok {
-			s.fullupdate = true
-			return nil, ErrSessionEnded
-		}
+	// Hold back if the connection is busy rather than block
+	outbound := s.apicall.Outbound
+	return cap(outbound) == 0 || len(outbound) < cap(outbound)
+}
 
-		s.updateEventTimestamp()
+// Sends the current GUI state to the client and then checks for an inbound update.  If block
+// is true, then it waits until an update arrives, the done channel is closed, or the interrupt
+// channel is selected.  Any chunks of large blobs are sent to the client while waiting.
+func (s *_Session) exchangeUpdates(done <-chan struct{}, interrupt chan bool, block bool) (updated Primitive, err error) {
+	if s.incompatible {
+		return nil, ErrIncompatibleClient
+	}
 
-		if len(updateIn) == 0 {
-			return nil, nil
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	// A failure ends this session only, rather than the whole process, and disconnects the
+	// client so it can connect again.  A panic in the application's own code is passed on.
+	defer func() {
+		if s.callingHandler {
+			s.callingHandler = false
+			return
+		}
+		if r := recover(); r != nil {
+			s.logger.Error("session failed", "client", s.ClientAddr(), "panic", r, "stack", string(debug.Stack()))
+			s.failure = fmt.Errorf("%w: %v", ErrSessionEnded, r)
+			s.apicall.Abort()
+			updated, err = nil, s.failure
 		}
+	}()
 
-		return s.synchro.IngestUpdate(updateIn)
+	if !s.isFlushDue(block) {
+		if !s.isgui {
+			return nil, errors.New("no GUI has been set")
+		}
+		return s.pollUpdate()
+	}
 
-	case <-s.apicall.CallHasExited:
-		return nil, ErrSessionEnded
+	// Send any update left over from the previous exchange first.
+	if s.unsentUpdate != nil {
+		if err := s.sendUpdate(s.unsentUpdate, done, interrupt); err != nil {
+			return nil, err
+		}
+		s.unsentUpdate = nil
 	}
-}
 
-// WaitOrCancel is like Wait but also returns ErrCanceled if the provided
-// context is canceled before an update arrives from the client.
-func (s *_Session) WaitOrCancel(ctx context.Context, interrupt chan bool) (Primitive, error) {
 	updateOut, err := s.getNextUpdate()
 	if err != nil {
 		return nil, err
 	}
 
-	// Send update to client.
-	select {
-	case s.apicall.Outbound <- updateOut:
-		break
-	case <-ctx.Done():
-		return nil, ErrCanceled
-	case <-interrupt:
-		return nil, ErrInterrupted
-	case <-s.apicall.CallHasExited:
-		return nil, ErrSessionEnded
+	if err := s.sendUpdate(updateOut, done, interrupt); err != nil {
+		s.unsentUpdate = updateOut
+		return nil, err
 	}
 
-	// Wait for inbound update or cancelation.
-	select {
-	case updateIn, ok := <-s.apicall.Inbound:
-		if !ok {
-			s.fullupdate = true
-			return nil, ErrSessionEnded
+	s.lastFlush = time.Now()
+
+	for {
+		var outbound chan []byte
+		var debounceExpired <-chan time.Time
+
+		// Keep sending chunks of large blobs while waiting on the client.  An update that
+		// isn't sent before returning is kept for next time.
+		if block && s.unsentUpdate == nil && s.synchro.HasBlobTransfers() {
+			s.unsentUpdate, err = s.getPartialUpdate()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if s.unsentUpdate != nil {
+			outbound = s.apicall.Outbound
 		}
 
-		s.updateEventTimestamp()
+		if !block {
+			return s.pollUpdate()
+		}
 
-		if len(updateIn) == 0 {
-			return nil, nil
+		if s.debounced != nil {
+			debounceExpired = s.debounceTimer.C
 		}
 
-		p, err := s.synchro.IngestUpdate(updateIn)
-		return p, err
+		select {
+		case outbound <- s.unsentUpdate:
+			s.unsentUpdate = nil
+			s.synchro.confirmBlobsSent()
+		case updateIn, ok := <-s.apicall.Inbound:
+			p, err := s.ingestUpdate(updateIn, ok)
+			if s.handleIngestError(err) || s.handleKeyBinding(p) {
+				continue
+			}
+			if held, released := s.debounce(p); held {
+				if released != nil {
+					return released, nil
+				}
+				continue
+			}
+			return p, err
+		case <-debounceExpired:
+			return s.releaseDebounced(), nil
+		case <-done:
+			return nil, ErrCanceled
+		case <-interrupt:
+			return nil, ErrInterrupted
+		case <-s.apicall.CallHasExited:
+			return nil, ErrSessionEnded
+		}
+	}
+}
 
-	case <-ctx.Done():
-		return nil, ErrCanceled
+// Sends an update to the client, blocking until it is sent, the done channel is closed,
+// or the interrupt channel is selected.
+func (s *_Session) sendUpdate(update []byte, done <-chan struct{}, interrupt chan bool) error {
+	select {
+	case s.apicall.Outbound <- update:
+		s.synchro.confirmBlobsSent()
+		return nil
+	case <-done:
+		return ErrCanceled
 	case <-interrupt:
-		return nil, ErrInterrupted
+		return ErrInterrupted
 	case <-s.apicall.CallHasExited:
-		return nil, ErrSessionEnded
+		return ErrSessionEnded
 	}
 }
 
-// Update sends the current GUI state to the client and checks for an
-// inbound update without blocking. Returns the primitive that was updated or
-// nil if no update is available. Returns an error if unsuccessful such as
-// ErrSessionDisconnected or ErrServingStopped.
-func (s *_Session) Update() (Primitive, error) {
-	updateOut, err := s.getNextUpdate()
-	if err != nil {
-		return nil, err
-	}
-
-	// Send update to client.
-	select {
-	case s.apicall.Outbound <- updateOut:
-		break
-	case <-s.apicall.CallHasExited:
-		return nil, ErrSessionEnded
+// Checks for an inbound update without blocking.
+func (s *_Session) pollUpdate() (Primitive, error) {
+	if s.debounced != nil && !time.Now().Before(s.debounceUntil) {
+		return s.releaseDebounced(), nil
 	}
 
-	// Non-blocking check for inbound update.
 	select {
 	case updateIn, ok := <-s.apicall.Inbound:
-		if !ok {
-			s.fullupdate = true
-			return nil, ErrSessionEnded
+		p, err := s.ingestUpdate(updateIn, ok)
+		if s.handleIngestError(err) || s.handleKeyBinding(p) {
+			return nil, nil
+		}
+		if held, released := s.debounce(p); held {
+			return released, nil
 		}
+		return p, err
+	default:
+		return nil, nil
+	}
+}
 
-		s.updateEventTimestamp()
+// Passes an IngestError along to the ingest error handler, if there is one.  Returns true
+// if the error was handled.  A request from the client for blobs missing from its cache is
+// always handled, since the blobs are sent again while waiting.
+func (s *_Session) handleIngestError(err error) bool {
+	if err == errBlobsRequested {
+		return true
+	}
+	var ie *IngestError
+	if s.ingestErrorHandler == nil || !errors.As(err, &ie) {
+		return false
+	}
+	s.ingestErrorHandler(ie)
+	return true
+}
 
-		if len(updateIn) == 0 {
-			return nil, nil
+// Calls the handler bound to a key if p is the session's KeyEvent and a key was pressed.
+// Returns true if p was the session's KeyEvent, so the key press isn't returned.
+func (s *_Session) handleKeyBinding(p Primitive) bool {
+	if p == nil || p != Primitive(s.keyEvent) {
+		return false
+	}
+	if handler := s.keyHandlers[s.keyEvent.Key()]; handler != nil && s.keyEvent.Pressed() {
+		s.callingHandler = true
+		handler()
+		s.callingHandler = false
+	}
+	return true
+}
+
+// Holds back p if it is to be debounced.  Returns true if p is held back, along with a primitive
+// held back before that can be returned in its place, if any.
+func (s *_Session) debounce(p Primitive) (held bool, released Primitive) {
+	d, ok := p.(debouncer)
+	if !ok {
+		return false, nil
+	}
+
+	delay := d.debounceDelay()
+	if delay <= 0 {
+		if s.debounced == d {
+			s.clearDebounced()
 		}
-		return s.synchro.IngestUpdate(updateIn)
-	default:
+		return false, nil
+	}
+
+	if s.debounced != nil && s.debounced != d {
+		released = s.releaseDebounced()
+	}
+	s.debounced = d
+	s.debounceUntil = time.Now().Add(delay)
+	if s.debounceTimer == nil {
+		s.debounceTimer = time.NewTimer(delay)
+	} else {
+		s.debounceTimer.Reset(delay)
+	}
+	return true, released
+}
+
+// Returns the primitive held back by debounce, issuing its update for a new Wait cycle.
+//
+// The held primitive is returned in a Wait cycle of its own, after any other updates that
+// arrived while it was held.  Events issued by those other primitives, such as a Command
+// being issued, are no longer reported once it is returned.
+func (s *_Session) releaseDebounced() Primitive {
+	d := s.debounced
+	s.clearDebounced()
+	s.updateEventTimestamp()
+	d.reissue()
+	return d
+}
+
+// Stops holding back a primitive.
+func (s *_Session) clearDebounced() {
+	s.debounced = nil
+	if s.debounceTimer != nil {
+		s.debounceTimer.Stop()
+	}
+}
+
+// Ingests an update received from the client.  The ok argument is false if the
+// inbound channel was closed.
+func (s *_Session) ingestUpdate(updateIn []byte, ok bool) (Primitive, error) {
+	if !ok {
+		s.fullupdate = true
+		return nil, ErrSessionEnded
+	}
+
+	s.updateEventTimestamp()
+
+	if len(updateIn) == 0 {
 		return nil, nil
 	}
+
+	return s.synchro.IngestUpdate(updateIn)
 }
 
 func (s *_Session) getNextUpdate() ([]byte, error) {
@@ -206,9 +575,119 @@ func (s *_Session) getNextUpdate() ([]byte, error) {
 		return nil, errors.New("no GUI has been set")
 	}
 
+	// Expired notifications are dropped before they are sent again
+	if s.notificationArea != nil {
+		s.notificationArea.prune(time.Now())
+	}
+
+	// A full update is tried again next time if it fails
 	if s.fullupdate {
+		update, err := s.synchro.GetFullUpdate()
+		if err != nil {
+			return nil, err
+		}
 		s.fullupdate = false
-		return s.synchro.GetFullUpdate()
+		return update, nil
+	}
+
+	return s.getPartialUpdate()
+}
+
+// Gets the pending updates to send to the client.  Updates that can't be egested, such as for a
+// primitive that panics, are logged and left out so the rest of the GUI keeps working.
+func (s *_Session) getPartialUpdate() ([]byte, error) {
+	update, err := s.synchro.GetPartialUpdate()
+	if err != nil && update != nil {
+		s.logger.Error("unable to send update", "client", s.ClientAddr(), "error", err)
+		err = nil
+	}
+	return update, err
+}
+
+// RunDialog shows a dialog on top of the GUI and handles updates from the client until one of
+// the dialog's buttons is issued or the client dismisses the dialog.
+func (s *_Session) RunDialog(ctx context.Context, dialog *Dialog) (*Command, error) {
+	if !s.synchro.isSupportedPrimitive(dialog) {
+		return nil, ErrNotSupported
+	}
+	if s.inGUI(dialog) {
+		return nil, errors.New("dialog is already part of the GUI")
+	}
+
+	s.addOverlay(dialog)
+	dialog.SetShowing(true)
+	defer dialog.SetShowing(false)
+
+	for {
+		_, err := s.WaitOrCancel(ctx, nil)
+		if err != nil {
+			var ie *IngestError
+			if errors.As(err, &ie) {
+				continue
+			}
+			return nil, err
+		}
+
+		if button := dialog.IssuedButton(); button != nil {
+			return button, nil
+		}
+		if !dialog.Showing() {
+			return nil, nil
+		}
+	}
+}
+
+// Confirm asks the user to confirm something using a dialog with OK and Cancel buttons.
+func (s *_Session) Confirm(ctx context.Context, message string) (bool, error) {
+	if s.confirmDialog == nil {
+		s.confirmDialog = NewDialog("")
+	}
+
+	ok := NewCommand("OK")
+	s.confirmDialog.SetDialogItemsVA(NewText(message)).SetButtons(ok, NewCommand("Cancel"))
+
+	button, err := s.RunDialog(ctx, s.confirmDialog)
+	return button == ok, err
+}
+
+// Notify shows a transient message to the user on top of the GUI.
+func (s *_Session) Notify(message string, level NotificationLevel, duration time.Duration) error {
+	if s.notificationArea == nil {
+		s.notificationArea = &NotificationArea{}
 	}
-	return s.synchro.GetPartialUpdate()
+	if !s.synchro.isSupportedPrimitive(s.notificationArea) {
+		return ErrNotSupported
+	}
+
+	s.addOverlay(s.notificationArea)
+	s.notificationArea.add(NotificationWith{Message: message, Level: level, Duration: duration}.Make(), time.Now())
+	return nil
+}
+
+// BindKey calls handler whenever the user presses a key while the App has focus.
+func (s *_Session) BindKey(keys string, handler func()) error {
+	k, err := NormalizeKey(keys)
+	if err != nil {
+		return err
+	}
+
+	if s.keyEvent == nil {
+		s.keyEvent = &KeyEvent{}
+		s.keyHandlers = map[string]func(){}
+	}
+	if !s.synchro.isSupportedPrimitive(s.keyEvent) {
+		return ErrNotSupported
+	}
+
+	if handler == nil {
+		delete(s.keyHandlers, k)
+	} else {
+		s.keyHandlers[k] = handler
+	}
+
+	// An empty list of keys would receive every key, so disable it instead
+	s.keyEvent.keys.Set(slices.Sorted(maps.Keys(s.keyHandlers)))
+	s.keyEvent.SetEnabled(len(s.keyHandlers) > 0)
+	s.addOverlay(s.keyEvent)
+	return nil
 }
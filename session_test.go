@@ -8,15 +8,24 @@ package golib
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
 	"testing"
+	"time"
 
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/prontogui/golib/key"
 	"github.com/prontogui/golib/pgcomm"
+	"google.golang.org/grpc/metadata"
 )
 
 func newTestSession() (Session, *pgcomm.StreamingAPICall) {
 	apicall := &pgcomm.StreamingAPICall{
-		Inbound:  make(chan []byte, 2),
-		Outbound: make(chan []byte, 2),
+		Inbound:       make(chan []byte, 2),
+		Outbound:      make(chan []byte, 2),
+		CallHasExited: make(chan byte),
 	}
 	s := NewSession(apicall)
 	return s, apicall
@@ -32,11 +41,14 @@ func Test_Session_Wait_NoGUI(t *testing.T) {
 }
 
 func Test_Session_Wait_Disconnected(t *testing.T) {
-	s, _ := newTestSession()
+	s, conn := newTestSession()
 
 	txt := TextWith{Content: "hello"}.Make()
 	s.SetGUI(txt)
 
+	// Simulate the client disconnecting
+	close(conn.CallHasExited)
+
 	_, err := s.Wait()
 	if err == nil {
 		t.Fatal("expected error on disconnected session")
@@ -104,3 +116,760 @@ func Test_Session_WaitOrCancel_Cancelled(t *testing.T) {
 		t.Fatalf("expecting ErrCanceled to be returned; got unexpected error: %v", err)
 	}
 }
+
+func Test_Session_Wait_SendsBlobChunks(t *testing.T) {
+	conn := &pgcomm.StreamingAPICall{
+		Inbound:       make(chan []byte, 2),
+		Outbound:      make(chan []byte, 2),
+		CallHasExited: make(chan byte),
+		Capabilities:  []string{CapabilityBlobChunks},
+	}
+	s := NewSession(conn)
+
+	ef := ExportFileWith{Data: make([]byte, DefaultBlobChunkSize*2+1)}.Make()
+	s.SetGUI(ef)
+
+	// Simulate client: read the full update followed by the chunks, then send an empty update
+	received := make(chan int)
+	go func() {
+		count := 0
+		for range 4 {
+			<-conn.Outbound
+			count++
+		}
+		conn.Inbound <- []byte{}
+		received <- count
+	}()
+
+	_, err := s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count := <-received; count != 4 {
+		t.Fatalf("client received %d updates.  Expecting 4", count)
+	}
+	if ef.Progress() != 1 {
+		t.Fatal("export file was not completely sent")
+	}
+}
+
+func Test_Session_Wait_ResendsMissingBlob(t *testing.T) {
+	conn := &pgcomm.StreamingAPICall{
+		Inbound:       make(chan []byte, 2),
+		Outbound:      make(chan []byte, 2),
+		CallHasExited: make(chan byte),
+		Capabilities:  []string{CapabilityBlobCache},
+	}
+	s := NewSession(conn)
+
+	ef := ExportFileWith{Data: make([]byte, minTransferBlobSize)}.Make()
+	s.SetGUI(ef)
+
+	// Simulate client: read the full update, report the blob missing from the cache, then read
+	// the blob sent again and send an empty update
+	resent := make(chan any)
+	go func() {
+		<-conn.Outbound
+		missing, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"Data": map[any]any{"Hash": ef.data.Hash()}}})
+		conn.Inbound <- missing
+
+		var update []any
+		cbor.Unmarshal(<-conn.Outbound, &update)
+		conn.Inbound <- []byte{}
+		if len(update) == 3 {
+			resent <- update[2].(map[any]any)["Data"]
+		} else {
+			resent <- update
+		}
+	}()
+
+	updated, err := s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatal("the request for a missing blob was returned as an update")
+	}
+	if data, ok := (<-resent).([]byte); !ok || len(data) != minTransferBlobSize {
+		t.Fatal("the missing blob was not sent again in full")
+	}
+}
+
+func Test_Session_Update_FlushPolicyCoalesces(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetFlushPolicy(FlushPolicy{MinInterval: time.Hour})
+
+	txt := TextWith{Content: "0"}.Make()
+	s.SetGUI(txt)
+
+	// The first update is sent right away
+	if _, err := s.Update(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-conn.Outbound
+
+	// Later updates are held back until the interval elapses
+	for i := range 1000 {
+		txt.SetContent(fmt.Sprint(i))
+		if _, err := s.Update(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(conn.Outbound) != 0 {
+		t.Fatal("update was sent before the minimum interval elapsed")
+	}
+
+	// Wait sends the latest value only
+	conn.Inbound <- []byte{}
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var updates []any
+	if err := cbor.Unmarshal(<-conn.Outbound, &updates); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("partial update has %d items.  Expecting 3", len(updates))
+	}
+	verifyUpdateItemMap(t, updates[2], map[string]any{"Content": "999"})
+}
+
+func Test_Session_Update_FlushPolicyDoesNotBlock(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetFlushPolicy(MaxUpdateRate(1000000))
+
+	txt := TextWith{Content: "0"}.Make()
+	s.SetGUI(txt)
+
+	// Nobody is reading the outbound channel so it fills up
+	for i := range 10 {
+		txt.SetContent(fmt.Sprint(i))
+		time.Sleep(time.Microsecond)
+		if _, err := s.Update(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(conn.Outbound) != cap(conn.Outbound) {
+		t.Fatal("expecting the outbound channel to be full")
+	}
+}
+
+func Test_MaxUpdateRate(t *testing.T) {
+	if MaxUpdateRate(50).MinInterval != 20*time.Millisecond {
+		t.Error("incorrect interval for 50 updates per second")
+	}
+	if MaxUpdateRate(0).MinInterval != 0 {
+		t.Error("expecting no interval for a rate of zero")
+	}
+}
+
+func Test_Session_FieldNamesFromMetadata(t *testing.T) {
+	apicall := &pgcomm.StreamingAPICall{
+		Inbound:       make(chan []byte, 2),
+		Outbound:      make(chan []byte, 2),
+		CallHasExited: make(chan byte),
+		Metadata:      metadata.Pairs(FieldNamesMetadataKey, "Content, Embodiment", FieldNamesMetadataKey, "Tag"),
+		Capabilities:  []string{CapabilityFieldCodes},
+	}
+	s := newSession(apicall, newOptions())
+
+	names := s.synchro.fieldNames
+	if len(names) != 3 || names[0] != "Content" || names[1] != "Embodiment" || names[2] != "Tag" {
+		t.Fatalf("field names are %v.  Expecting [Content Embodiment Tag]", names)
+	}
+}
+
+func Test_Session_HandshakeWithoutCapabilities(t *testing.T) {
+	apicall := &pgcomm.StreamingAPICall{
+		Metadata: metadata.Pairs(FieldNamesMetadataKey, "Content,Tag", PrimitivesMetadataKey, "Text"),
+	}
+	s := newSession(apicall, newOptions())
+
+	if s.synchro.fieldNames != nil {
+		t.Error("field codes are used without the field-codes capability")
+	}
+	if len(s.synchro.supportedFields) != 2 || !s.synchro.supportedFields["Tag"] {
+		t.Error("supported fields were not set")
+	}
+	if len(s.synchro.supportedPrimitives) != 1 || !s.synchro.supportedPrimitives["Text"] {
+		t.Error("supported primitives were not set")
+	}
+	if s.synchro.blobCaching {
+		t.Error("blob caching is on without the blob-cache capability")
+	}
+	if s.synchro.blobChunkSize != math.MaxInt {
+		t.Error("blob chunking is on without the blob-chunks capability")
+	}
+	if s.synchro.pointsAppend {
+		t.Error("points are appended without the points-append capability")
+	}
+}
+
+func Test_Session_HandshakeWithPointsAppend(t *testing.T) {
+	apicall := &pgcomm.StreamingAPICall{Capabilities: []string{CapabilityPointsAppend}}
+
+	if s := newSession(apicall, newOptions()); !s.synchro.pointsAppend {
+		t.Error("points are not appended with the points-append capability")
+	}
+}
+
+func Test_Session_HandshakeWithBlobChunks(t *testing.T) {
+	apicall := &pgcomm.StreamingAPICall{Capabilities: []string{CapabilityBlobChunks}}
+
+	if s := newSession(apicall, newOptions()); s.synchro.blobChunkSize != DefaultBlobChunkSize {
+		t.Errorf("blob chunk size is %d.  Expecting the default", s.synchro.blobChunkSize)
+	}
+	if s := newSession(apicall, newOptions(WithBlobChunkSize(64*1024))); s.synchro.blobChunkSize != 64*1024 {
+		t.Errorf("blob chunk size is %d.  Expecting the size given as an option", s.synchro.blobChunkSize)
+	}
+}
+
+func Test_Session_Wait_IncompatibleClient(t *testing.T) {
+	apicall := &pgcomm.StreamingAPICall{
+		Inbound:       make(chan []byte, 2),
+		Outbound:      make(chan []byte, 2),
+		CallHasExited: make(chan byte),
+		Incompatible:  true,
+	}
+	s := NewSession(apicall)
+	s.SetGUI(TextWith{Content: "hello"}.Make())
+
+	_, err := s.Wait()
+	if err != ErrIncompatibleClient {
+		t.Fatalf("expecting ErrIncompatibleClient to be returned; got %v", err)
+	}
+}
+
+func Test_Session_Wait_ReturnsIngestError(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetGUI(CommandWith{Label: "OK"}.Make())
+
+	go func() {
+		<-conn.Outbound
+		update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"Label": 5}})
+		conn.Inbound <- update
+	}()
+
+	_, err := s.Wait()
+
+	var ie *IngestError
+	if !errors.As(err, &ie) || ie.Field != "Label" {
+		t.Fatalf("expecting an IngestError for the Label field; got %v", err)
+	}
+}
+
+func Test_Session_Wait_IngestErrorHandler(t *testing.T) {
+	s, conn := newTestSession()
+
+	cmd := CommandWith{Label: "OK"}.Make()
+	s.SetGUI(cmd)
+
+	var handled []*IngestError
+	s.SetIngestErrorHandler(func(err *IngestError) {
+		handled = append(handled, err)
+	})
+
+	go func() {
+		<-conn.Outbound
+		conn.Inbound <- []byte{0xff}
+		update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"Label": "Cancel"}})
+		conn.Inbound <- update
+	}()
+
+	p, err := s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != cmd || cmd.Label() != "Cancel" {
+		t.Fatal("expecting the command to be updated")
+	}
+	if len(handled) != 1 || !errors.Is(handled[0], ErrMalformedUpdate) {
+		t.Fatalf("expecting the handler to be called once for the malformed update; got %v", handled)
+	}
+}
+
+func Test_Session_Wait_PrimitivePanics(t *testing.T) {
+	s, _ := newTestSession()
+	s.SetGUI(&PanickingPrimitive{})
+
+	_, err := s.Wait()
+	if err == nil {
+		t.Fatal("expecting an error from a primitive that panics")
+	}
+}
+
+func Test_Session_FailureEndsSession(t *testing.T) {
+	s, _ := newTestSession()
+	s.SetGUI(TextWith{Content: "hello"}.Make())
+
+	// Break the session so that it panics
+	s.(*_Session).synchro = nil
+
+	_, err := s.Update()
+	if !errors.Is(err, ErrSessionEnded) {
+		t.Fatalf("expecting an error wrapping ErrSessionEnded; got %v", err)
+	}
+
+	_, err2 := s.Wait()
+	if err2 != err {
+		t.Fatal("expecting the session to remain ended")
+	}
+}
+
+// Reads the full update sent to the client and returns its top-level primitives.
+func readFullUpdate(t *testing.T, conn *pgcomm.StreamingAPICall) []any {
+	var update []any
+	if err := cbor.Unmarshal(<-conn.Outbound, &update); err != nil {
+		t.Errorf("unable to decode update: %v", err)
+		return nil
+	}
+	if len(update) == 0 || update[0] != true {
+		t.Errorf("expecting a full update; got %v", update)
+		return nil
+	}
+	return update[1:]
+}
+
+func Test_Session_Confirm(t *testing.T) {
+	s, conn := newTestSession()
+
+	txt := NewText("hello")
+	s.SetGUI(txt)
+
+	go func() {
+		top := readFullUpdate(t, conn)
+		if len(top) != 2 {
+			t.Errorf("expecting the dialog to follow the GUI; got %v", top)
+		} else if dialog, _ := top[1].(map[any]any); dialog["Showing"] != true {
+			t.Errorf("expecting the dialog to be showing; got %v", dialog)
+		}
+
+		// Issue the OK button
+		update, _ := cbor.Marshal([]any{false, []any{1, 0, 0}, map[any]any{"CommandIssued": true}})
+		conn.Inbound <- update
+	}()
+
+	ok, err := s.Confirm(context.Background(), "Delete 5 rows?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok {
+		t.Fatal("expecting the user to have confirmed")
+	}
+
+	// The dialog is hidden with a partial update and the GUI is left alone
+	go func() {
+		var update []any
+		cbor.Unmarshal(<-conn.Outbound, &update)
+		if len(update) != 3 || update[0] != false {
+			t.Errorf("expecting a partial update hiding the dialog; got %v", update)
+		}
+		conn.Inbound <- []byte{}
+	}()
+
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txt.Content() != "hello" {
+		t.Fatal("GUI was changed")
+	}
+}
+
+func Test_Session_Confirm_Dismissed(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetGUI(NewText("hello"))
+
+	go func() {
+		readFullUpdate(t, conn)
+		update, _ := cbor.Marshal([]any{false, []any{1}, map[any]any{"Showing": false}})
+		conn.Inbound <- update
+	}()
+
+	ok, err := s.Confirm(context.Background(), "Delete 5 rows?")
+	if err != nil || ok {
+		t.Fatalf("expecting false without an error; got %v, %v", ok, err)
+	}
+}
+
+func Test_Session_RunDialog_Canceled(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetGUI(NewText("hello"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-conn.Outbound
+		cancel()
+	}()
+
+	dialog := NewDialog("Wait").SetButtons(NewCommand("Close"))
+	_, err := s.RunDialog(ctx, dialog)
+	if err != ErrCanceled {
+		t.Fatalf("expecting ErrCanceled; got %v", err)
+	}
+	if dialog.Showing() {
+		t.Fatal("dialog is still showing")
+	}
+}
+
+func Test_Session_RunDialog_InGUI(t *testing.T) {
+	s, conn := newTestSession()
+
+	dialog := NewDialog("Details").SetButtons(NewCommand("Close"))
+	s.SetGUI(NewGroup(NewText("hello"), NewFrame(dialog)))
+
+	if _, err := s.RunDialog(context.Background(), dialog); err == nil {
+		t.Fatal("expecting an error for a dialog that is part of the GUI")
+	}
+	if len(s.(*_Session).overlays) != 0 || dialog.Showing() {
+		t.Fatal("dialog in the GUI was shown as an overlay")
+	}
+	if len(conn.Outbound) != 0 {
+		t.Fatal("an update was sent for a dialog that can't be run")
+	}
+}
+
+func Test_Session_RunDialog_NotSupported(t *testing.T) {
+	apicall := &pgcomm.StreamingAPICall{
+		Inbound:       make(chan []byte, 2),
+		Outbound:      make(chan []byte, 2),
+		CallHasExited: make(chan byte),
+		Metadata:      metadata.Pairs(PrimitivesMetadataKey, "Text,Command"),
+	}
+	s := NewSession(apicall)
+	s.SetGUI(NewText("hello"))
+
+	if _, err := s.Confirm(context.Background(), "Sure?"); err != ErrNotSupported {
+		t.Fatalf("expecting ErrNotSupported; got %v", err)
+	}
+}
+
+func Test_Session_Notify(t *testing.T) {
+	s, conn := newTestSession()
+
+	txt := NewText("hello")
+	s.SetGUI(txt)
+
+	if err := s.Notify("Saved", NotifySuccess, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	go func() {
+		top := readFullUpdate(t, conn)
+		if len(top) != 2 {
+			t.Errorf("expecting the notifications to follow the GUI; got %v", top)
+		}
+		conn.Inbound <- []byte{}
+	}()
+
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Later notifications only update the notifications
+	if err := s.Notify("Import failed", NotifyError, time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	go func() {
+		var update []any
+		cbor.Unmarshal(<-conn.Outbound, &update)
+		if len(update) != 3 || update[0] != false {
+			t.Errorf("expecting a partial update; got %v", update)
+		} else if pkey, _ := update[1].([]any); len(pkey) != 1 || pkey[0] != uint64(1) {
+			t.Errorf("expecting an update to the notifications; got %v", update)
+		}
+		conn.Inbound <- []byte{}
+	}()
+
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if txt.Content() != "hello" {
+		t.Fatal("GUI was changed")
+	}
+}
+
+func Test_Session_NotifyBeforeSetGUI(t *testing.T) {
+	s, conn := newTestSession()
+
+	if err := s.Notify("Welcome", NotifyInfo, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.Wait(); err == nil {
+		t.Fatal("expecting an error when waiting without a GUI")
+	}
+
+	s.SetGUI(NewText("hello"))
+
+	go func() {
+		top := readFullUpdate(t, conn)
+		if len(top) != 2 {
+			t.Errorf("expecting the notifications to follow the GUI; got %v", top)
+		}
+		conn.Inbound <- []byte{}
+	}()
+
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func Test_Session_NotifyDropsExpired(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetGUI(NewText("hello"))
+
+	if err := s.Notify("Saved", NotifySuccess, time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	go func() {
+		readFullUpdate(t, conn)
+		conn.Inbound <- []byte{}
+	}()
+
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	// The expired notification is dropped without another call to Notify
+	go func() {
+		var update []any
+		cbor.Unmarshal(<-conn.Outbound, &update)
+		if len(update) != 3 {
+			t.Errorf("expecting an update to the notifications; got %v", update)
+		} else if fields, _ := update[2].(map[any]any); len(fields) != 1 || !reflect.DeepEqual(fields["NotificationItems"], []any{}) {
+			t.Errorf("expecting no notifications; got %v", update[2])
+		}
+		conn.Inbound <- []byte{}
+	}()
+
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func Test_Session_BindKey(t *testing.T) {
+	s, conn := newTestSession()
+
+	cmd := NewCommand("OK")
+	s.SetGUI(cmd)
+
+	saved := 0
+	if err := s.BindKey("ctrl+s", func() { saved++ }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.BindKey("Hyper+S", func() {}); err == nil {
+		t.Fatal("expecting an error for an invalid key")
+	}
+
+	go func() {
+		top := readFullUpdate(t, conn)
+		if len(top) != 2 {
+			t.Errorf("expecting the key event to follow the GUI; got %v", top)
+		} else if ke, _ := top[1].(map[any]any); !reflect.DeepEqual(ke["Keys"], []any{"Ctrl+S"}) {
+			t.Errorf("expecting the bound keys to be sent; got %v", ke)
+		}
+
+		// Press the bound key, which is handled, then issue the command
+		update, _ := cbor.Marshal([]any{false, []any{1}, map[any]any{"Key": "Ctrl+S", "KeyPressed": true}})
+		conn.Inbound <- update
+		update, _ = cbor.Marshal([]any{false, []any{0}, map[any]any{"CommandIssued": true}})
+		conn.Inbound <- update
+	}()
+
+	p, err := s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != cmd {
+		t.Fatalf("expecting the command to be returned; got %v", p)
+	}
+	if saved != 1 {
+		t.Fatalf("expecting the handler to be called once; got %d", saved)
+	}
+}
+
+func Test_Session_BindKey_HandlerPanics(t *testing.T) {
+	s, conn := newTestSession()
+	s.SetGUI(NewCommand("OK"))
+	s.BindKey("Ctrl+S", func() { panic("handler failed") })
+
+	go func() {
+		<-conn.Outbound
+		update, _ := cbor.Marshal([]any{false, []any{1}, map[any]any{"Key": "Ctrl+S", "KeyPressed": true}})
+		conn.Inbound <- update
+	}()
+
+	// The panic is passed on to the application rather than ending the session
+	defer func() {
+		if r := recover(); r != "handler failed" {
+			t.Fatalf("expecting the handler's panic to be passed on; got %v", r)
+		}
+		if s.(*_Session).failure != nil {
+			t.Fatal("the session failed because of the handler")
+		}
+	}()
+	s.Wait()
+	t.Fatal("expecting Wait to panic")
+}
+
+func Test_Session_Wait_DebouncesTextField(t *testing.T) {
+	s, conn := newTestSession()
+
+	search := TextFieldWith{Debounce: 50 * time.Millisecond}.Make()
+	s.SetGUI(search)
+
+	go func() {
+		<-conn.Outbound
+		for _, entry := range []string{"a", "ab"} {
+			update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"TextEntry": entry, "Changing": true}})
+			conn.Inbound <- update
+		}
+	}()
+
+	start := time.Now()
+	p, err := s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != search || !search.Changing() || search.TextEntry() != "ab" {
+		t.Fatalf("expecting the text field to be changing; got %v", p)
+	}
+	if time.Since(start) < 50*time.Millisecond {
+		t.Fatal("expecting the changes to be debounced")
+	}
+
+	// A commit is returned right away
+	go func() {
+		<-conn.Outbound
+		update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"TextEntry": "abc", "Changing": true}})
+		conn.Inbound <- update
+		update, _ = cbor.Marshal([]any{false, []any{0}, map[any]any{"Committed": true}})
+		conn.Inbound <- update
+	}()
+
+	p, err = s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != search || !search.Committed() || search.TextEntry() != "abc" {
+		t.Fatalf("expecting the text field to be committed; got %v", p)
+	}
+
+	// Nothing is left held back
+	go func() {
+		<-conn.Outbound
+		conn.Inbound <- []byte{}
+	}()
+	if p, _ := s.Wait(); p != nil {
+		t.Fatalf("expecting no update; got %v", p)
+	}
+}
+
+func Test_Session_Wait_OtherUpdateWhileDebouncing(t *testing.T) {
+	s, conn := newTestSession()
+
+	search := TextFieldWith{Debounce: 50 * time.Millisecond}.Make()
+	cmd := NewCommand("Go")
+	s.SetGUI(search, cmd)
+
+	go func() {
+		<-conn.Outbound
+		update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"TextEntry": "a", "Changing": true}})
+		conn.Inbound <- update
+		update, _ = cbor.Marshal([]any{false, []any{1}, map[any]any{"CommandIssued": true}})
+		conn.Inbound <- update
+	}()
+
+	// The command is returned while the text field is still held back
+	p, err := s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != cmd || !cmd.Issued() {
+		t.Fatalf("expecting the command to be issued; got %v", p)
+	}
+	if search.Changing() {
+		t.Error("the held text field is reported as changing with the command")
+	}
+
+	// The text field follows in a cycle of its own, with the command no longer issued
+	go func() {
+		<-conn.Outbound
+	}()
+	p, err = s.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != search || !search.Changing() || search.TextEntry() != "a" {
+		t.Fatalf("expecting the text field to be changing; got %v", p)
+	}
+	if cmd.Issued() {
+		t.Error("the command is still issued after the text field is returned")
+	}
+}
+
+// A primitive that panics when egesting a partial update.
+type partialPanickingPrimitive struct {
+	SimplePrimitive
+}
+
+func (p *partialPanickingPrimitive) EgestUpdate(fullupdate bool, fkeys []key.FKey) map[any]any {
+	if !fullupdate {
+		panic("egest failed")
+	}
+	return p.SimplePrimitive.EgestUpdate(fullupdate, fkeys)
+}
+
+func Test_Session_Wait_AfterPrimitivePanics(t *testing.T) {
+	s, conn := newTestSession()
+
+	good := &SimplePrimitive{}
+	bad := &partialPanickingPrimitive{}
+	s.SetGUI(good, bad)
+
+	// Reads the next update sent to the client and replies with an empty update
+	readUpdate := func() chan []any {
+		updates := make(chan []any, 1)
+		go func() {
+			var update []any
+			cbor.Unmarshal(<-conn.Outbound, &update)
+			updates <- update
+			conn.Inbound <- []byte{}
+		}()
+		return updates
+	}
+
+	updates := readUpdate()
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-updates
+
+	// The update of the primitive that panics is left out
+	bad.Label.Set("bad")
+	good.Label.Set("good")
+
+	updates = readUpdate()
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if update := <-updates; len(update) != 3 || !reflect.DeepEqual(update[1], []any{uint64(0)}) {
+		t.Fatalf("expecting only the update of the good primitive; got %v", update)
+	}
+
+	// Later updates carry on as usual
+	good.Label.Set("better")
+
+	updates = readUpdate()
+	if _, err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if update := <-updates; len(update) != 3 {
+		t.Fatalf("expecting the update of the good primitive; got %v", update)
+	}
+}
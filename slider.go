@@ -0,0 +1,280 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/prontogui/golib/key"
+)
+
+// Orientations of a Slider or RangeSlider.
+const (
+	OrientationHorizontal = "horizontal"
+	OrientationVertical   = "vertical"
+)
+
+// A control for choosing a value within a continuous range by dragging a thumb along a track.
+type SliderWith struct {
+	Embodiment  string
+	Max         float64
+	Min         float64
+	Orientation string
+	Status      int
+	Step        float64
+	Tag         string
+	Value       float64
+}
+
+// Creates a new Slider using the supplied field assignments.
+func (w SliderWith) Make() *Slider {
+	slider := &Slider{}
+	slider.embodiment.Set(w.Embodiment)
+	slider.max.Set(w.Max)
+	slider.min.Set(w.Min)
+	slider.orientation.Set(w.Orientation)
+	slider.status.Set(w.Status)
+	slider.step.Set(w.Step)
+	slider.tag.Set(w.Tag)
+	slider.value.Set(w.Value)
+	return slider
+}
+
+// A control for choosing a value within a continuous range by dragging a thumb along a track.
+//
+// While the user drags the thumb, the App sends the changing value and Changing returns true.
+// When the user releases the thumb, Committed returns true.
+type Slider struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	changing    EventField
+	committed   EventField
+	embodiment  StringField
+	max         FloatField
+	min         FloatField
+	orientation StringField
+	status      IntegerField
+	step        FloatField
+	tag         StringField
+	value       FloatField
+}
+
+// Creates a new Slider with a range of values and an initial value of min.
+func NewSlider(min, max float64) *Slider {
+	return SliderWith{Min: min, Max: max, Value: min}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (slider *Slider) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	slider.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Changing, &slider.changing},
+			{key.FKey_Committed, &slider.committed},
+			{key.FKey_Embodiment, &slider.embodiment},
+			{key.FKey_MaxValue, &slider.max},
+			{key.FKey_MinValue, &slider.min},
+			{key.FKey_Orientation, &slider.orientation},
+			{key.FKey_Status, &slider.status},
+			{key.FKey_Step, &slider.step},
+			{key.FKey_Tag, &slider.tag},
+			{key.FKey_Value, &slider.value},
+		}
+	})
+}
+
+// Ingests an update from the app.  A value outside of the range is clamped to the range, and
+// a value that is not a number is replaced by the previous value.  The corrected value is sent
+// back to the app.  This is used internally by this library and normally should not be called
+// by users of the library.
+func (slider *Slider) IngestUpdate(update map[any]any) error {
+	previous := slider.value.Get()
+	if err := slider.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	slider.value.Set(clampSliderValue(slider.value.Get(), previous, slider.min.Get(), slider.max.Get()))
+	return nil
+}
+
+// Returns a string representation of this primitive:  the value.
+// Implements of fmt:Stringer interface.
+func (slider *Slider) String() string {
+	return strconv.FormatFloat(slider.value.Get(), 'f', -1, 64)
+}
+
+// Returns the value chosen by the user.
+func (slider *Slider) Value() float64 {
+	return slider.value.Get()
+}
+
+// Sets the value chosen by the user.
+func (slider *Slider) SetValue(v float64) *Slider {
+	slider.value.Set(v)
+	return slider
+}
+
+// Returns the smallest value of the range.
+func (slider *Slider) Min() float64 {
+	return slider.min.Get()
+}
+
+// Sets the smallest value of the range.
+func (slider *Slider) SetMin(v float64) *Slider {
+	slider.min.Set(v)
+	return slider
+}
+
+// Returns the largest value of the range.
+func (slider *Slider) Max() float64 {
+	return slider.max.Get()
+}
+
+// Sets the largest value of the range.
+func (slider *Slider) SetMax(v float64) *Slider {
+	slider.max.Set(v)
+	return slider
+}
+
+// Returns the amount the value changes by at each step along the track, or 0 if the value
+// changes continuously.
+func (slider *Slider) Step() float64 {
+	return slider.step.Get()
+}
+
+// Sets the amount the value changes by at each step along the track, or 0 if the value
+// changes continuously.
+func (slider *Slider) SetStep(v float64) *Slider {
+	slider.step.Set(v)
+	return slider
+}
+
+// Returns the orientation of the track:  OrientationHorizontal, OrientationVertical, or empty
+// for the App's default.
+func (slider *Slider) Orientation() string {
+	return slider.orientation.Get()
+}
+
+// Sets the orientation of the track:  OrientationHorizontal, OrientationVertical, or empty
+// for the App's default.
+func (slider *Slider) SetOrientation(s string) *Slider {
+	slider.orientation.Set(s)
+	return slider
+}
+
+// Returns true if the user changed the value by dragging the thumb during the current Wait
+// cycle.  The value is still changing until Committed returns true.
+func (slider *Slider) Changing() bool {
+	return slider.changing.Issued()
+}
+
+// Returns true if the user released the thumb, settling on a value, during the current Wait
+// cycle.
+func (slider *Slider) Committed() bool {
+	return slider.committed.Issued()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (slider *Slider) Embodiment() string {
+	return slider.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (slider *Slider) SetEmbodiment(s string) *Slider {
+	slider.embodiment.Set(s)
+	return slider
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using Sliders as Table cells.
+func (slider *Slider) Tag() string {
+	return slider.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using Sliders as Table cells.
+func (slider *Slider) SetTag(s string) *Slider {
+	slider.tag.Set(s)
+	return slider
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Slider) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Slider) SetStatus(i int) *Slider {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Slider) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Slider) SetVisible(visible bool) *Slider {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Slider) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Slider) SetEnabled(enabled bool) *Slider {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Slider) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Slider) SetCollapsed(collapsed bool) *Slider {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
+
+// Returns a value from the App clamped to the range of a slider, or the previous value if it
+// is not a number.  The range only applies if max is greater than min.
+func clampSliderValue(v, previous, min, max float64) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return previous
+	}
+	if max > min {
+		return math.Max(min, math.Min(v, max))
+	}
+	return v
+}
@@ -0,0 +1,86 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_SliderAttach(t *testing.T) {
+	slider := &Slider{}
+	slider.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, slider.PrimitiveBase, "Changing", "Committed", "Embodiment", "MaxValue", "MinValue", "Orientation", "Status", "Step", "Tag", "Value")
+}
+
+func Test_SliderMake(t *testing.T) {
+	slider := SliderWith{
+		Max:         10,
+		Min:         -10,
+		Orientation: OrientationVertical,
+		Step:        0.5,
+		Value:       2.5,
+	}.Make()
+
+	if slider.Min() != -10 || slider.Max() != 10 {
+		t.Error("could not initialize Min and Max fields")
+	}
+	if slider.Orientation() != OrientationVertical {
+		t.Error("could not initialize Orientation field")
+	}
+	if slider.Step() != 0.5 {
+		t.Error("could not initialize Step field")
+	}
+	if slider.Value() != 2.5 || slider.String() != "2.5" {
+		t.Error("could not initialize Value field")
+	}
+}
+
+func Test_SliderEvents(t *testing.T) {
+	ts := time.Now()
+	slider := NewSlider(0, 100)
+	slider.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if err := slider.IngestUpdate(map[any]any{"Value": 40.0, "Changing": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !slider.Changing() || slider.Committed() || slider.Value() != 40 {
+		t.Error("value is not shown as changing")
+	}
+
+	ts = ts.Add(time.Second)
+	if err := slider.IngestUpdate(map[any]any{"Value": uint64(42), "Committed": true}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Changing() || !slider.Committed() || slider.Value() != 42 {
+		t.Error("value is not shown as committed")
+	}
+}
+
+func Test_SliderIngestOutOfRange(t *testing.T) {
+	slider := NewSlider(0, 100).SetValue(50)
+	var sentBack bool
+	slider.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack = true }, getBogeyEventTimestampProvider())
+
+	if err := slider.IngestUpdate(map[any]any{"Value": 101.0}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Value() != 100 || !sentBack {
+		t.Errorf("value outside of the range was not clamped and sent back.  Value is %v", slider.Value())
+	}
+
+	sentBack = false
+	if err := slider.IngestUpdate(map[any]any{"Value": math.NaN()}); err != nil {
+		t.Fatal(err)
+	}
+	if slider.Value() != 100 || !sentBack {
+		t.Errorf("value that is not a number was not replaced and sent back.  Value is %v", slider.Value())
+	}
+}
@@ -21,7 +21,12 @@ func (f *String1DField) Get() []string {
 	return f.sa
 }
 
+// Sets the strings.  Nothing is sent to the App if the strings are equal to the current
+// strings, unless it's the same slice, since it might have been modified in place.
 func (f *String1DField) Set(sa []string) {
+	if equalSlices(sa, f.sa) {
+		return
+	}
 	f.sa = sa
 	f.OnSet(false)
 }
@@ -77,3 +77,33 @@ func Test_String1DIngestUpdateInvalid(t *testing.T) {
 		t.Fatal("wrong error was returned")
 	}
 }
+
+func Test_String1DSetSameValue(t *testing.T) {
+	f := String1DField{}
+	f.Set([]string{"a", "b"})
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set([]string{"a", "b"})
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+
+	f.Set([]string{"a", "c"})
+	if !testOnsetCalled {
+		t.Error("onset was not called when the value changed")
+	}
+}
+
+func Test_String1DSetSameSlice(t *testing.T) {
+	f := String1DField{}
+	f.Set([]string{"a", "b"})
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	sa := f.Get()
+	sa[1] = "z"
+	f.Set(sa)
+
+	if !testOnsetCalled {
+		t.Error("onset was not called after setting a slice that was modified in place")
+	}
+}
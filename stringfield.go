@@ -22,6 +22,9 @@ func (f *StringField) Get() string {
 }
 
 func (f *StringField) Set(s string) {
+	if s == f.s {
+		return
+	}
 	f.s = s
 	f.OnSet(false)
 }
@@ -84,3 +84,15 @@ func Test_StringIngestUpdateInvalid(t *testing.T) {
 	err := f.IngestValue(false)
 	verifyIngestUpdateInvalid(t, err)
 }
+
+func Test_StringSetSameValue(t *testing.T) {
+	f := StringField{}
+	f.Set("abc")
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set("abc")
+
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A nested menu within a Menu or another Submenu.
+type SubmenuWith struct {
+	Embodiment   string
+	Icon         Primitive
+	Label        string
+	Status       int
+	SubmenuItems []Primitive
+	Tag          string
+}
+
+// Makes a new Submenu with specified field values.
+func (w SubmenuWith) Make() *Submenu {
+	submenu := &Submenu{}
+	submenu.embodiment.Set(w.Embodiment)
+	submenu.icon.Set(w.Icon)
+	submenu.label.Set(w.Label)
+	submenu.status.Set(w.Status)
+	submenu.submenuItems.Set(w.SubmenuItems)
+	submenu.tag.Set(w.Tag)
+	return submenu
+}
+
+// A nested menu within a Menu or another Submenu.  It is shown as an item with a label and
+// an optional icon that opens its own items, which are MenuItems and Submenus.
+type Submenu struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment   StringField
+	icon         AnyField
+	label        StringField
+	status       IntegerField
+	submenuItems Any1DField
+	tag          StringField
+}
+
+// Creates a new Submenu with a label and its items, which are MenuItems and Submenus.
+func NewSubmenu(label string, items ...Primitive) *Submenu {
+	return SubmenuWith{Label: label, SubmenuItems: items}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (submenu *Submenu) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	submenu.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &submenu.embodiment},
+			{key.FKey_Icon, &submenu.icon},
+			{key.FKey_Label, &submenu.label},
+			{key.FKey_Status, &submenu.status},
+			{key.FKey_SubmenuItems, &submenu.submenuItems},
+			{key.FKey_Tag, &submenu.tag},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (submenu *Submenu) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	nextIndex := locator.NextIndex()
+
+	switch nextIndex {
+	case 0:
+		return submenu.Icon()
+	case 1:
+		return itemAt(submenu.SubmenuItems(), locator.NextIndex())
+	}
+
+	return nil
+}
+
+// Returns a string representation of this primitive:  the label.
+// Implements of fmt:Stringer interface.
+func (submenu *Submenu) String() string {
+	return submenu.label.Get()
+}
+
+// Returns the menu item within this submenu, or any nested submenu, that was issued during
+// the current Wait cycle.  Returns nil if none was issued.
+func (submenu *Submenu) IssuedItem() *MenuItem {
+	return issuedMenuItem(submenu.SubmenuItems())
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (submenu *Submenu) Embodiment() string {
+	return submenu.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (submenu *Submenu) SetEmbodiment(s string) *Submenu {
+	submenu.embodiment.Set(s)
+	return submenu
+}
+
+// Returns the optional icon shown next to the label.
+func (submenu *Submenu) Icon() Primitive {
+	return submenu.icon.Get()
+}
+
+// Sets the optional icon shown next to the label.
+func (submenu *Submenu) SetIcon(p Primitive) *Submenu {
+	submenu.icon.Set(p)
+	return submenu
+}
+
+// Returns the label to display for the submenu.
+func (submenu *Submenu) Label() string {
+	return submenu.label.Get()
+}
+
+// Sets the label to display for the submenu.
+func (submenu *Submenu) SetLabel(s string) *Submenu {
+	submenu.label.Set(s)
+	return submenu
+}
+
+// Returns the items of the submenu, which are MenuItems and Submenus.
+func (submenu *Submenu) SubmenuItems() []Primitive {
+	return submenu.submenuItems.Get()
+}
+
+// Sets the items of the submenu, which are MenuItems and Submenus.
+func (submenu *Submenu) SetSubmenuItems(items []Primitive) *Submenu {
+	submenu.submenuItems.Set(items)
+	return submenu
+}
+
+// Sets the items (variadic argument list) of the submenu, which are MenuItems and Submenus.
+func (submenu *Submenu) SetSubmenuItemsVA(items ...Primitive) *Submenu {
+	submenu.submenuItems.Set(items)
+	return submenu
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (submenu *Submenu) Tag() string {
+	return submenu.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (submenu *Submenu) SetTag(s string) *Submenu {
+	submenu.tag.Set(s)
+	return submenu
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Submenu) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Submenu) SetStatus(i int) *Submenu {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Submenu) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Submenu) SetVisible(visible bool) *Submenu {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Submenu) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Submenu) SetEnabled(enabled bool) *Submenu {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Submenu) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Submenu) SetCollapsed(collapsed bool) *Submenu {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
@@ -9,6 +9,7 @@ package golib
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	cbor "github.com/fxamacker/cbor/v2"
 	"github.com/prontogui/golib/key"
@@ -23,32 +24,88 @@ type Update struct {
 type Synchro struct {
 	primitives []Primitive
 
+	// Pending updates in the order they were first made, along with a trie of the same
+	// updates indexed by pkey.
 	pendingUpdates []*Update
+	pendingIndex   updateNode
+
+	// Maximum number of bytes of a blob to send in a single update.
+	blobChunkSize int
+
+	// Blobs being sent to the App in chunks.
+	blobTransfers []*blobTransfer
+
+	// Hashes of the blobs that the App has in its cache.
+	clientBlobs *blobHashes
+
+	// Hashes of the blobs in the update being sent, which the App will have in its cache once
+	// the update is sent.  True if the blob is sent whole, so the rest of the update can refer
+	// to it.
+	sendingBlobs map[string]bool
+
+	// True if the App asked for blobs missing from its cache, which are sent again with the
+	// next partial update.
+	blobsRequested bool
+
+	// True if blobs sent to the App are kept in its cache.
+	blobCaching bool
+
+	// True if the App can append points to a chart series.
+	pointsAppend bool
+
+	// Field names known by the App, indexed by the codes it uses for them, and the reverse.
+	fieldNames []string
+	fieldCodes map[string]int
+
+	// Fields and primitive types supported by the App, or nil if all are supported.
+	supportedFields     map[string]bool
+	supportedPrimitives map[string]bool
 }
 
+// Creates a new Synchro.  Blobs are sent whole until chunking is turned on by SetBlobChunkSize,
+// and sent every time until caching is turned on by SetBlobCaching.
 func NewSynchro() *Synchro {
-	return &Synchro{}
+	return &Synchro{blobChunkSize: math.MaxInt, clientBlobs: newBlobHashes()}
 }
 
-func findPendingUpdate(updates []*Update, pkey key.PKey) *Update {
-	for _, update := range updates {
-		if update.pkey.EqualTo(pkey) && !update.ignored {
-			return update
+// A node in the trie of pending updates.  Each level of the trie corresponds to a level
+// of the pkey, so the pending update for a primitive is found by walking its pkey.
+type updateNode struct {
+	update   *Update
+	children map[int]*updateNode
+}
+
+// Returns the node for pkey, adding nodes along the way as needed.
+func (n *updateNode) locate(pkey key.PKey) *updateNode {
+	for _, index := range pkey {
+		child, ok := n.children[index]
+		if !ok {
+			if n.children == nil {
+				n.children = map[int]*updateNode{}
+			}
+			child = &updateNode{}
+			n.children[index] = child
 		}
+		n = child
 	}
-	return nil
+	return n
 }
 
-func ignoreDescendantUpdates(updates []*Update, pkey key.PKey) {
-	for _, update := range updates {
-		if update.pkey.DescendsFrom(pkey) {
-			update.ignored = true
+// Marks the pending updates of all descendants as ignored and removes them from the trie.
+// Each node is removed once at most, so the cost is spread over the calls that added them.
+func (n *updateNode) ignoreDescendants() {
+	for _, child := range n.children {
+		if child.update != nil {
+			child.update.ignored = true
 		}
+		child.ignoreDescendants()
 	}
+	n.children = nil
 }
 
 func appendFieldToUpdate(update *Update, fkey key.FKey) {
 
+	// The number of fields is limited to those of a single primitive
 	for _, nextfkey := range update.fields {
 		// Already been recorded as an update?
 		if nextfkey == fkey {
@@ -59,36 +116,67 @@ func appendFieldToUpdate(update *Update, fkey key.FKey) {
 	update.fields = append(update.fields, fkey)
 }
 
+// Returns the primitive at pkey, or nil if there isn't one.
 func locatePrimitive(primitives []Primitive, pkey key.PKey) Primitive {
 
 	locator := key.NewPKeyLocator(pkey)
 
 	// Get one of the top-level primitives to start with
-	next := primitives[locator.NextIndex()]
+	next := itemAt(primitives, locator.NextIndex())
+
+	for next != nil && !locator.Located() {
+		level := locator.LocationLevel
 
-	for !locator.Located() {
 		// Try finding a descendant at the next level down
 		next = next.LocateNextDescendant(locator)
+
+		// A primitive that didn't advance the locator has no descendants
+		if locator.LocationLevel == level {
+			return nil
+		}
 	}
 
 	return next
 }
 
+// Egests an update from a primitive.  A panic in the primitive is returned as an error so
+// that it doesn't take down the whole process.
+func egestUpdate(p Primitive, fullupdate bool, fkeys []key.FKey) (update map[any]any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("primitive %T panicked while egesting an update: %v", p, r)
+		}
+	}()
+	return p.EgestUpdate(fullupdate, fkeys), nil
+}
+
+// Ingests an update into a primitive.  A panic in the primitive is returned as an error so
+// that it doesn't take down the whole process.
+func ingestUpdate(p Primitive, update map[any]any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("primitive %T panicked while ingesting an update: %v", p, r)
+		}
+	}()
+	return p.IngestUpdate(update)
+}
+
 func (s *Synchro) OnSet(pkey key.PKey, fkey key.FKey, structural bool) {
 
+	node := s.pendingIndex.locate(pkey)
+
 	// is there pending update for this primitive?
-	existingUpdate := findPendingUpdate(s.pendingUpdates, pkey)
-	if existingUpdate != nil {
-		appendFieldToUpdate(existingUpdate, fkey)
+	if node.update != nil {
+		appendFieldToUpdate(node.update, fkey)
 	} else {
 		// Add a new update to pending
-		newUpdate := &Update{pkey: pkey}
-		newUpdate.fields = []key.FKey{fkey}
-		s.pendingUpdates = append(s.pendingUpdates, newUpdate)
+		node.update = &Update{pkey: pkey}
+		node.update.fields = []key.FKey{fkey}
+		s.pendingUpdates = append(s.pendingUpdates, node.update)
 	}
 
 	if structural {
-		ignoreDescendantUpdates(s.pendingUpdates, pkey)
+		node.ignoreDescendants()
 	}
 }
 
@@ -96,6 +184,8 @@ func (s *Synchro) SetTopPrimitives(etsprovider EventTimestampProvider, primitive
 	//	s.pendingUpdates = make(map[key.PKey][primitive.MaxPrimitiveFields]key.FKey)
 
 	s.primitives = primitives
+	s.blobTransfers = nil
+	s.sendingBlobs = nil
 
 	var pkey key.PKey
 
@@ -108,31 +198,63 @@ func (s *Synchro) GetTopPrimitives() []Primitive {
 	return s.primitives
 }
 
+// Returns the pending updates to send to the App, which are then cleared.  An update that
+// can't be egested, such as for a primitive that panics, is left out and its error is
+// returned along with the rest of the updates.
 func (s *Synchro) GetPartialUpdate() ([]byte, error) {
 
-	if len(s.pendingUpdates) == 0 {
+	s.sendingBlobs = map[string]bool{}
+	s.blobsRequested = false
+
+	if len(s.pendingUpdates) == 0 && len(s.blobTransfers) == 0 {
 		return cbor.Marshal(nil)
 	}
 
+	// Clear the pending updates, even if some fail, so they aren't tried again
+	defer func() {
+		s.pendingUpdates = []*Update{}
+		s.pendingIndex = updateNode{}
+	}()
+
 	updateList := []any{false}
+	var errs []error
 
 	for _, update := range s.pendingUpdates {
-		if !update.ignored {
+		if !update.ignored && s.isSupportedAt(update.pkey) {
 
 			// Locate the primitive
 			found := locatePrimitive(s.primitives, update.pkey)
-
-			m := found.EgestUpdate(false, update.fields)
+			if found == nil {
+				errs = append(errs, fmt.Errorf("primitive at pkey %v was not found for update", update.pkey))
+				continue
+			}
+
+			egested, err := egestUpdate(found, false, update.fields)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			m := s.encodePrimitive(found, egested)
+			if len(m) == 0 {
+				continue
+			}
 
 			// Add pkey and map to array of updates
 			updateList = append(updateList, update.pkey, m)
 		}
 	}
 
-	// Clear the pending updates
-	s.pendingUpdates = []*Update{}
+	// Include the next chunk of any blob being transferred
+	if pkey, m, ok := s.nextBlobChunk(); ok {
+		updateList = append(updateList, pkey, m)
+	}
 
-	return cbor.Marshal(updateList)
+	updateCbor, err := cbor.Marshal(updateList)
+	if err != nil {
+		return nil, err
+	}
+	return updateCbor, errors.Join(errs...)
 }
 
 func (s *Synchro) GetFullUpdate() ([]byte, error) {
@@ -141,11 +263,18 @@ func (s *Synchro) GetFullUpdate() ([]byte, error) {
 		return nil, nil
 	}
 
+	// Transfers underway are restarted by the full update
+	s.blobTransfers = nil
+	s.sendingBlobs = map[string]bool{}
+
 	l := []any{true}
 
 	for _, p := range s.primitives {
-		p.EgestUpdate(true, nil)
-		l = append(l, p.EgestUpdate(true, nil))
+		egested, err := egestUpdate(p, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, s.encodePrimitive(p, egested))
 	}
 
 	return cbor.Marshal(l)
@@ -155,8 +284,8 @@ func (s *Synchro) IngestUpdate(updatesCbor []byte) (updatedPrimitive Primitive,
 
 	var updates any
 
-	updateError = cbor.Unmarshal(updatesCbor, &updates)
-	if updateError != nil {
+	if err := cbor.Unmarshal(updatesCbor, &updates); err != nil {
+		updateError = &IngestError{Reason: &ingestReason{ErrMalformedUpdate, err}}
 		return
 	}
 
@@ -165,7 +294,7 @@ func (s *Synchro) IngestUpdate(updatesCbor []byte) (updatedPrimitive Primitive,
 	// Expecting a list of interfaces
 	updatesList, ok := updates.([]any)
 	if !ok {
-		updateError = errors.New("the unmarshalled updates do not represent a list.  Expecting a list of updates")
+		updateError = malformedUpdateError("the unmarshalled updates do not represent a list.  Expecting a list of updates")
 		return
 	}
 
@@ -173,19 +302,19 @@ func (s *Synchro) IngestUpdate(updatesCbor []byte) (updatedPrimitive Primitive,
 
 	// Must have length >= 1
 	if len(updatesList) == 0 {
-		updateError = errors.New("update must have atleast one value, the full/partial update flag")
+		updateError = malformedUpdateError("update must have atleast one value, the full/partial update flag")
 		return
 	}
 
 	// Parse the full/partial update flag
 	isfull, ok := updatesList[0].(bool)
 	if !ok {
-		updateError = errors.New("update value for full/partial flag is incorrect.  Expecting a bool")
+		updateError = malformedUpdateError("update value for full/partial flag is incorrect.  Expecting a bool")
 		return
 	}
 
 	if isfull {
-		updateError = errors.New("ingestion of full updates is not supported")
+		updateError = malformedUpdateError("ingestion of full updates is not supported")
 		return
 	}
 
@@ -195,32 +324,45 @@ func (s *Synchro) IngestUpdate(updatesCbor []byte) (updatedPrimitive Primitive,
 	}
 
 	if numitems != 3 {
-		updateError = errors.New("partial update is limited to one primitive")
+		updateError = malformedUpdateError("partial update is limited to one primitive")
 		return
 	}
 
 	// Get the pkey
 	pkeyany, ok := updatesList[1].([]any)
 	if !ok {
-		updateError = errors.New("unable to convert pkey item to PKey")
+		updateError = malformedUpdateError("unable to convert pkey item to PKey")
 		return
 	}
 
 	// Get the update map
 	m, ok := updatesList[2].(map[any]any)
 	if !ok {
-		updateError = errors.New("unable to convert update item to map[any]any")
+		updateError = malformedUpdateError("unable to convert update item to map[any]any")
 		return
 	}
 
 	pkey := key.NewPKeyFromAny(pkeyany...)
 	updatedPrimitive = locatePrimitive(s.primitives, pkey)
 	if updatedPrimitive == nil {
-		updateError = fmt.Errorf("primitive at pkey = %v was not found", pkey)
+		updateError = &IngestError{PKey: pkey, Reason: ErrPrimitiveNotFound}
+		return
+	}
+
+	m, err := s.decodeFieldKeys(m)
+	if err != nil {
+		updateError = locateIngestError(pkey, err)
 		return
 	}
 
-	updateError = updatedPrimitive.IngestUpdate(m)
+	// An update that only asks for blobs missing from the App's cache isn't returned
+	if s.resendMissingBlobs(pkey, m) && len(m) == 0 {
+		return nil, errBlobsRequested
+	}
+
+	if err := ingestUpdate(updatedPrimitive, m); err != nil {
+		updateError = locateIngestError(pkey, err)
+	}
 
 	return
 
@@ -8,6 +8,8 @@ package golib
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -301,3 +303,568 @@ func Test_IngestPartialUpdate(t *testing.T) {
 	// Are top primitives the same?
 	verifyPrimitivesEqual(t, s1.GetTopPrimitives(), s2.GetTopPrimitives())
 }
+
+func Test_PartialUpdateBlobChunks(t *testing.T) {
+
+	p := &ComplexPrimitive{}
+
+	s := NewSynchro()
+	s.SetBlobChunkSize(minTransferBlobSize)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p)
+
+	blob := make([]byte, minTransferBlobSize*2+10)
+	for i := range blob {
+		blob[i] = byte(i)
+	}
+	p.Data.Set(blob)
+
+	// First update has the size of the blob followed by the first chunk
+	updates := getPartialUpdateItems(t, s)
+	if len(updates) != 5 {
+		t.Fatalf("partial update returned %d items.  Expecting 5 items", len(updates))
+	}
+	verifyUpdateItemMap(t, updates[2], map[string]any{"Data": map[any]any{"Size": uint64(len(blob)), "Hash": p.Data.Hash()}})
+
+	// Then one chunk per update
+	assembled := []byte{}
+	for {
+		verifyUpdateItemPKey(t, updates[len(updates)-2], key.NewPKey(0))
+
+		chunk := updates[len(updates)-1].(map[any]any)["Data"].(map[any]any)
+		if chunk["Offset"] != uint64(len(assembled)) {
+			t.Fatal("chunk has the wrong offset")
+		}
+		assembled = append(assembled, chunk["Bytes"].([]byte)...)
+
+		if !s.HasBlobTransfers() {
+			break
+		}
+
+		updates = getPartialUpdateItems(t, s)
+		if len(updates) != 3 {
+			t.Fatalf("partial update returned %d items.  Expecting 3 items", len(updates))
+		}
+	}
+
+	if !bytes.Equal(assembled, blob) {
+		t.Fatal("chunks do not add up to the original blob")
+	}
+	if p.Data.Progress() != 1 {
+		t.Fatal("progress is not complete after sending all chunks")
+	}
+}
+
+func Test_FullUpdateRestartsBlobTransfer(t *testing.T) {
+
+	p := &ComplexPrimitive{}
+	p.Data.Set(make([]byte, minTransferBlobSize*3))
+
+	s := NewSynchro()
+	s.SetBlobChunkSize(minTransferBlobSize)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p)
+
+	s.GetFullUpdate()
+	s.GetPartialUpdate()
+	s.GetFullUpdate()
+
+	if len(s.blobTransfers) != 1 || s.blobTransfers[0].offset != 0 {
+		t.Fatal("full update did not restart the blob transfer")
+	}
+}
+
+func Test_BlobTransferCanceledWhenReassigned(t *testing.T) {
+
+	p := &ComplexPrimitive{}
+
+	s := NewSynchro()
+	s.SetBlobChunkSize(minTransferBlobSize)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p)
+
+	p.Data.Set(make([]byte, minTransferBlobSize*3))
+	s.GetPartialUpdate()
+
+	p.Data.Set([]byte{1, 2, 3})
+	updates := getPartialUpdateItems(t, s)
+
+	if len(updates) != 3 {
+		t.Fatalf("partial update returned %d items.  Expecting 3 items", len(updates))
+	}
+	verifyUpdateItemMap(t, updates[2], map[string]any{"Data": []byte{1, 2, 3}})
+
+	if s.HasBlobTransfers() {
+		t.Fatal("blob transfer continued after the blob was reassigned")
+	}
+}
+
+func getPartialUpdateItems(t *testing.T, s *Synchro) []any {
+	updatesCbor, err := s.GetPartialUpdate()
+	if err != nil {
+		t.Fatalf("unexpected error %s while getting partial update", err.Error())
+	}
+
+	var updates []any
+	err = cbor.Unmarshal(updatesCbor, &updates)
+	if err != nil {
+		t.Fatalf("attempt to unmarshall updates resulted in error of %s", err.Error())
+	}
+	return updates
+}
+
+func Test_FullUpdateRefersToCachedBlobs(t *testing.T) {
+
+	blob := make([]byte, minTransferBlobSize)
+
+	p1 := &ComplexPrimitive{}
+	p1.Data.Set(blob)
+	p2 := &ComplexPrimitive{}
+	p2.Data.Set(append([]byte{}, blob...))
+
+	s := NewSynchro()
+	s.SetBlobCaching(true)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p1, p2)
+
+	updates := getFullUpdateItems(t, s)
+
+	// The second primitive has the same blob so it only refers to it by hash
+	if !bytes.Equal(updates[1].(map[any]any)["Data"].([]byte), blob) {
+		t.Fatal("first occurrence of blob was not sent in full")
+	}
+	if !reflect.DeepEqual(updates[2].(map[any]any)["Data"], map[any]any{"Hash": p1.Data.Hash()}) {
+		t.Fatal("second occurrence of blob was not sent by reference")
+	}
+
+	// Once sent, subsequent full updates only refer to the blob
+	s.confirmBlobsSent()
+	updates = getFullUpdateItems(t, s)
+	if !reflect.DeepEqual(updates[1].(map[any]any)["Data"], map[any]any{"Hash": p1.Data.Hash()}) {
+		t.Fatal("blob was sent again in a subsequent full update")
+	}
+}
+
+func Test_IngestMissingBlobResendsIt(t *testing.T) {
+
+	blob := make([]byte, minTransferBlobSize)
+
+	p := &ComplexPrimitive{}
+	p.Data.Set(blob)
+
+	s := NewSynchro()
+	s.SetBlobCaching(true)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p)
+	s.clientBlobs.add(p.Data.Hash())
+
+	// App reports the blob is missing from its cache, which isn't an update of the primitive
+	missing, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"Data": map[any]any{"Hash": p.Data.Hash()}}})
+	updated, err := s.IngestUpdate(missing)
+	if updated != nil || err != errBlobsRequested {
+		t.Fatalf("ingesting the request returned %v, %v.  Expecting nil, errBlobsRequested", updated, err)
+	}
+	if !s.HasBlobTransfers() {
+		t.Fatal("requested blob is not waiting to be sent")
+	}
+
+	updates := getPartialUpdateItems(t, s)
+	if len(updates) != 3 {
+		t.Fatalf("partial update returned %d items.  Expecting 3 items", len(updates))
+	}
+	verifyUpdateItemMap(t, updates[2], map[string]any{"Data": blob})
+	if s.HasBlobTransfers() {
+		t.Error("requested blob is still waiting to be sent")
+	}
+}
+
+func Test_BlobsCachedOnlyOnceSent(t *testing.T) {
+
+	p := &ComplexPrimitive{}
+	p.Data.Set(make([]byte, minTransferBlobSize))
+
+	s := NewSynchro()
+	s.SetBlobCaching(true)
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p)
+
+	// The first update was never sent, so the blob is sent again in full
+	getFullUpdateItems(t, s)
+	updates := getFullUpdateItems(t, s)
+	if _, ok := updates[1].(map[any]any)["Data"].([]byte); !ok {
+		t.Fatal("blob was referred to by hash before it was sent")
+	}
+
+	s.confirmBlobsSent()
+	if !s.clientBlobs.has(p.Data.Hash()) {
+		t.Fatal("blob was not recorded as cached once sent")
+	}
+}
+
+func Test_ClientBlobsAreCapped(t *testing.T) {
+
+	h := newBlobHashes()
+	for i := range maxClientBlobs + 10 {
+		h.add(fmt.Sprint(i))
+	}
+	h.remove("500")
+
+	if len(h.hashes) != maxClientBlobs-1 || len(h.order) != maxClientBlobs-1 {
+		t.Fatalf("remembering %d blobs.  Expecting %d", len(h.hashes), maxClientBlobs-1)
+	}
+	if h.has("0") || h.has("9") || !h.has("10") || h.has("500") {
+		t.Error("the oldest blobs were not forgotten first")
+	}
+}
+
+func getFullUpdateItems(t *testing.T, s *Synchro) []any {
+	updatesCbor, err := s.GetFullUpdate()
+	if err != nil {
+		t.Fatalf("unexpected error %s while getting full update", err.Error())
+	}
+
+	var updates []any
+	err = cbor.Unmarshal(updatesCbor, &updates)
+	if err != nil {
+		t.Fatalf("attempt to unmarshall updates resulted in error of %s", err.Error())
+	}
+	return updates
+}
+
+func Test_PartialUpdateIgnoresDescendantsOfStructuralChange(t *testing.T) {
+
+	cell := TextWith{Content: "A"}.Make()
+	table := TableWith{Rows: [][]Primitive{{cell}}}.Make()
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), table)
+
+	cell.SetContent("B")
+	table.SetRows([][]Primitive{{TextWith{Content: "C"}.Make()}})
+
+	updates := getPartialUpdateItems(t, s)
+
+	if len(updates) != 3 {
+		t.Fatalf("partial update returned %d items.  Expecting 3 items", len(updates))
+	}
+	verifyUpdateItemPKey(t, updates[1], key.NewPKey(0))
+}
+
+func Test_PartialUpdateAfterStructuralChange(t *testing.T) {
+
+	cell := TextWith{Content: "A"}.Make()
+	table := TableWith{Rows: [][]Primitive{{TextWith{Content: "A"}.Make()}}}.Make()
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), table)
+
+	table.SetRows([][]Primitive{{cell}})
+	cell.SetContent("B")
+	table.SetStatus(1)
+
+	updates := getPartialUpdateItems(t, s)
+
+	if len(updates) != 5 {
+		t.Fatalf("partial update returned %d items.  Expecting 5 items", len(updates))
+	}
+	verifyUpdateItemPKey(t, updates[1], key.NewPKey(0))
+	verifyUpdateItemPKey(t, updates[3], key.NewPKey(0, 2, 0, 0))
+	verifyUpdateItemMap(t, updates[4], map[string]any{"Content": "B"})
+}
+
+// Measures the cost of updating every cell of a table with the given number of rows.  The
+// time per cell (ns/cell) should stay about the same as the number of rows grows.
+func benchmarkTableUpdate(b *testing.B, numRows int) {
+
+	const numCols = 10
+
+	rows := make([][]Primitive, numRows)
+	for i := range rows {
+		rows[i] = make([]Primitive, numCols)
+		for j := range rows[i] {
+			rows[i][j] = TextWith{}.Make()
+		}
+	}
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), TableWith{Rows: rows}.Make())
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		content := fmt.Sprint(n)
+		for _, row := range rows {
+			for _, cell := range row {
+				cell.(*Text).SetContent(content)
+			}
+		}
+		if _, err := s.GetPartialUpdate(); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*numRows*numCols), "ns/cell")
+}
+
+func Benchmark_TableUpdate(b *testing.B) {
+	for _, numRows := range []int{10, 100, 1000, 10000} {
+		b.Run(fmt.Sprintf("rows=%d", numRows), func(b *testing.B) {
+			benchmarkTableUpdate(b, numRows)
+		})
+	}
+}
+
+func Test_PartialUpdateWithFieldCodes(t *testing.T) {
+
+	cmd := &SimplePrimitive{}
+
+	s := NewSynchro()
+	s.SetFieldNames([]string{"Issued", "Label"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), cmd)
+
+	cmd.Label.Set("Hello")
+	cmd.Status.Set(1)
+
+	updates := getPartialUpdateItems(t, s)
+
+	m, ok := updates[2].(map[any]any)
+	if !ok {
+		t.Fatal("update item is not a map")
+	}
+	if len(m) != 2 || m[uint64(1)] != "Hello" || m["Status"] != uint64(1) {
+		t.Fatalf("update is %v.  Expecting Label to be sent by code and Status by name", m)
+	}
+}
+
+func Test_FullUpdateWithFieldCodes(t *testing.T) {
+
+	s := NewSynchro()
+	s.SetFieldNames([]string{"Content", "Embodiment", "Tag"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), TextWith{Content: "abc"}.Make())
+
+	updates := getFullUpdateItems(t, s)
+
+	m, ok := updates[1].(map[any]any)
+	if !ok {
+		t.Fatal("update item is not a map")
+	}
+	if m[uint64(0)] != "abc" {
+		t.Fatalf("update is %v.  Expecting Content to be sent by code", m)
+	}
+	for k := range m {
+		if name, ok := k.(string); ok && (name == "Content" || name == "Embodiment" || name == "Tag") {
+			t.Errorf("field %s was sent by name", name)
+		}
+	}
+}
+
+func Test_IngestUpdateWithFieldCodes(t *testing.T) {
+
+	cmd := &SimplePrimitive{}
+
+	s := NewSynchro()
+	s.SetFieldNames([]string{"Issued", "Label"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), cmd)
+
+	update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{1: "Hello", "Status": 2}})
+
+	_, err := s.IngestUpdate(update)
+	if err != nil {
+		t.Fatalf("IngestUpdate returned error:  %s", err.Error())
+	}
+	if cmd.Label.Get() != "Hello" || cmd.Status.Get() != 2 {
+		t.Fatal("fields were not ingested")
+	}
+
+	update, _ = cbor.Marshal([]any{false, []any{0}, map[any]any{5: "Hello"}})
+
+	_, err = s.IngestUpdate(update)
+	if err == nil {
+		t.Fatal("expecting an error for an unknown field code")
+	}
+}
+
+func Test_FullUpdateLeavesOutUnsupportedFields(t *testing.T) {
+
+	s := NewSynchro()
+	s.SetSupportedFields([]string{"Content"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), TextWith{Content: "abc", Tag: "T"}.Make())
+
+	updates := getFullUpdateItems(t, s)
+
+	verifyUpdateItemMap(t, updates[1], map[string]any{"Content": "abc"})
+}
+
+func Test_FullUpdateLeavesOutUnsupportedPrimitives(t *testing.T) {
+
+	group := GroupWith{GroupItems: []Primitive{
+		TextWith{Content: "abc"}.Make(),
+		CommandWith{Label: "OK"}.Make(),
+	}}.Make()
+
+	s := NewSynchro()
+	s.SetSupportedPrimitives([]string{"Group", "Text"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), group, CommandWith{Label: "Cancel"}.Make())
+
+	updates := getFullUpdateItems(t, s)
+
+	if m, ok := updates[2].(map[any]any); !ok || len(m) != 0 {
+		t.Errorf("top-level command was sent as %v.  Expecting an empty primitive", updates[2])
+	}
+
+	m, ok := updates[1].(map[any]any)
+	if !ok {
+		t.Fatal("group was not sent as a map")
+	}
+	items, ok := m["GroupItems"].([]any)
+	if !ok || len(items) != 2 {
+		t.Fatal("group items were not sent")
+	}
+	if text, ok := items[0].(map[any]any); !ok || text["Content"] != "abc" {
+		t.Error("text within the group was not sent")
+	}
+	if cmd, ok := items[1].(map[any]any); !ok || len(cmd) != 0 {
+		t.Errorf("command within the group was sent as %v.  Expecting an empty primitive", items[1])
+	}
+}
+
+func Test_PartialUpdateLeavesOutUnsupportedPrimitives(t *testing.T) {
+
+	cmd := CommandWith{Label: "OK"}.Make()
+	group := GroupWith{GroupItems: []Primitive{cmd}}.Make()
+	text := TextWith{Content: "abc"}.Make()
+
+	s := NewSynchro()
+	s.SetSupportedPrimitives([]string{"Text"})
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), group, text)
+
+	cmd.SetLabel("Cancel")
+	text.SetContent("def")
+
+	updates := getPartialUpdateItems(t, s)
+
+	if len(updates) != 3 {
+		t.Fatalf("partial update returned %d items.  Expecting 3 items", len(updates))
+	}
+	verifyUpdateItemPKey(t, updates[1], key.NewPKey(1))
+}
+
+func Test_BlobsSentWholeWithoutChunking(t *testing.T) {
+
+	ef := ExportFileWith{Data: make([]byte, DefaultBlobChunkSize*2)}.Make()
+
+	// Chunking and caching are off by default
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), ef)
+
+	for range 2 {
+		updates := getFullUpdateItems(t, s)
+
+		m, ok := updates[1].(map[any]any)
+		if !ok {
+			t.Fatal("export file was not sent as a map")
+		}
+		if data, ok := m["Data"].([]byte); !ok || len(data) != DefaultBlobChunkSize*2 {
+			t.Fatal("data was not sent whole")
+		}
+	}
+
+	if s.HasBlobTransfers() {
+		t.Error("expecting no blob transfers")
+	}
+}
+
+func Test_IngestUpdateInvalidValue(t *testing.T) {
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), &SimplePrimitive{}, &SimplePrimitive{})
+
+	update, _ := cbor.Marshal([]any{false, []any{1}, map[any]any{"Label": 99}})
+
+	_, err := s.IngestUpdate(update)
+
+	var ie *IngestError
+	if !errors.As(err, &ie) {
+		t.Fatalf("expecting an IngestError; got %v", err)
+	}
+	if !errors.Is(err, ErrInvalidValue) {
+		t.Error("expecting the error to be ErrInvalidValue")
+	}
+	if !ie.PKey.EqualTo(key.NewPKey(1)) || ie.Field != "Label" {
+		t.Errorf("error is for pkey %v and field %s.  Expecting pkey [1] and field Label", ie.PKey, ie.Field)
+	}
+}
+
+func Test_IngestUpdateMalformed(t *testing.T) {
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), &SimplePrimitive{})
+
+	_, err := s.IngestUpdate([]byte{0xff})
+
+	var ie *IngestError
+	if !errors.As(err, &ie) || !errors.Is(err, ErrMalformedUpdate) {
+		t.Fatalf("expecting an IngestError for a malformed update; got %v", err)
+	}
+}
+
+func Test_IngestUpdatePrimitiveNotFound(t *testing.T) {
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), GroupWith{GroupItems: []Primitive{&SimplePrimitive{}}}.Make())
+
+	for _, pkey := range [][]any{{1}, {0, 0, 5}, {0, 0, 0, 0}, {0, 7}} {
+		update, _ := cbor.Marshal([]any{false, pkey, map[any]any{"Label": "abc"}})
+
+		_, err := s.IngestUpdate(update)
+		if !errors.Is(err, ErrPrimitiveNotFound) {
+			t.Errorf("expecting ErrPrimitiveNotFound for pkey %v; got %v", pkey, err)
+		}
+	}
+}
+
+// A primitive that panics when egesting or ingesting updates.
+type PanickingPrimitive struct {
+	SimplePrimitive
+}
+
+func (p *PanickingPrimitive) EgestUpdate(fullupdate bool, fkeys []key.FKey) map[any]any {
+	panic("egest failed")
+}
+
+func (p *PanickingPrimitive) IngestUpdate(update map[any]any) error {
+	panic("ingest failed")
+}
+
+func Test_PanicWhileEgestingIsReturnedAsError(t *testing.T) {
+
+	p := &PanickingPrimitive{}
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), p)
+
+	if _, err := s.GetFullUpdate(); err == nil {
+		t.Error("expecting an error from the full update")
+	}
+
+	p.Label.Set("abc")
+
+	if _, err := s.GetPartialUpdate(); err == nil {
+		t.Error("expecting an error from the partial update")
+	}
+
+	// The failed update isn't tried again
+	emptyUpdate, _ := cbor.Marshal(nil)
+	if pu, err := s.GetPartialUpdate(); err != nil || !bytes.Equal(pu, emptyUpdate) {
+		t.Errorf("expecting no partial update after a failed one; got %v, %v", pu, err)
+	}
+}
+
+func Test_PanicWhileIngestingIsReturnedAsError(t *testing.T) {
+
+	s := NewSynchro()
+	s.SetTopPrimitives(getBogeyEventTimestampProvider(), &PanickingPrimitive{})
+
+	update, _ := cbor.Marshal([]any{false, []any{0}, map[any]any{"Label": "abc"}})
+
+	_, err := s.IngestUpdate(update)
+
+	var ie *IngestError
+	if !errors.As(err, &ie) || !ie.PKey.EqualTo(key.NewPKey(0)) {
+		t.Fatalf("expecting an IngestError for pkey [0]; got %v", err)
+	}
+}
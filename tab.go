@@ -0,0 +1,199 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A page of a Tabs container, with a title and optional icon shown on its tab.
+type TabWith struct {
+	Icon      Primitive
+	PageItems []Primitive
+	Status    int
+	Tag       string
+	Title     string
+}
+
+// Creates a new Tab using the supplied field assignments.
+func (w TabWith) Make() *Tab {
+	tab := &Tab{}
+	tab.icon.Set(w.Icon)
+	tab.pageItems.Set(w.PageItems)
+	tab.status.Set(w.Status)
+	tab.tag.Set(w.Tag)
+	tab.title.Set(w.Title)
+	return tab
+}
+
+// A page of a Tabs container, with a title and optional icon shown on its tab.
+type Tab struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	icon      AnyField
+	pageItems Any1DField
+	status    IntegerField
+	tag       StringField
+	title     StringField
+}
+
+// Creates a new Tab with a title and the primitives of its page.
+func NewTab(title string, items ...Primitive) *Tab {
+	return TabWith{Title: title, PageItems: items}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (tab *Tab) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	tab.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Icon, &tab.icon},
+			{key.FKey_PageItems, &tab.pageItems},
+			{key.FKey_Status, &tab.status},
+			{key.FKey_Tag, &tab.tag},
+			{key.FKey_Title, &tab.title},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (tab *Tab) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	nextIndex := locator.NextIndex()
+
+	switch nextIndex {
+	case 0:
+		return tab.Icon()
+	case 1:
+		return itemAt(tab.PageItems(), locator.NextIndex())
+	}
+
+	return nil
+}
+
+// Returns a string representation of this primitive:  the title.
+// Implements of fmt:Stringer interface.
+func (tab *Tab) String() string {
+	return tab.title.Get()
+}
+
+// Returns the title shown on the tab.
+func (tab *Tab) Title() string {
+	return tab.title.Get()
+}
+
+// Sets the title shown on the tab.
+func (tab *Tab) SetTitle(s string) *Tab {
+	tab.title.Set(s)
+	return tab
+}
+
+// Returns the optional icon shown on the tab.
+func (tab *Tab) Icon() Primitive {
+	return tab.icon.Get()
+}
+
+// Sets the optional icon shown on the tab.
+func (tab *Tab) SetIcon(p Primitive) *Tab {
+	tab.icon.Set(p)
+	return tab
+}
+
+// Returns the collection of primitives shown on the page.
+func (tab *Tab) PageItems() []Primitive {
+	return tab.pageItems.Get()
+}
+
+// Sets the collection of primitives shown on the page.
+func (tab *Tab) SetPageItems(items []Primitive) *Tab {
+	tab.pageItems.Set(items)
+	return tab
+}
+
+// Sets the collection of primitives (variadic argument list) shown on the page.
+func (tab *Tab) SetPageItemsVA(items ...Primitive) *Tab {
+	tab.pageItems.Set(items)
+	return tab
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (tab *Tab) Tag() string {
+	return tab.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (tab *Tab) SetTag(s string) *Tab {
+	tab.tag.Set(s)
+	return tab
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Tab) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Tab) SetStatus(i int) *Tab {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Tab) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Tab) SetVisible(visible bool) *Tab {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Tab) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Tab) SetEnabled(enabled bool) *Tab {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Tab) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Tab) SetCollapsed(collapsed bool) *Tab {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
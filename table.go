@@ -92,18 +92,18 @@ func (table *Table) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 	// Fields are handled in alphabetical order
 	switch nextIndex {
 	case 0:
-		return table.HeaderRow()[locator.NextIndex()]
+		return itemAt(table.HeaderRow(), locator.NextIndex())
 	case 1:
-		return table.ModelRow()[locator.NextIndex()]
+		return itemAt(table.ModelRow(), locator.NextIndex())
 	case 2:
 		// TODO:  Optimization - add a row/col accessor to Any2D field so we don't return all the contents just
 		// to index a single item here.  Same could be done for Any1D.
 		row := locator.NextIndex()
 		col := locator.NextIndex()
-		return table.Rows()[row][col]
+		return cellAt(table.Rows(), row, col)
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a JSON string specifying the embodiment to use for this primitive.
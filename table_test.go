@@ -317,3 +317,14 @@ func Test_TableDeleteAllRows(t *testing.T) {
 		t.Fatalf("number of rows after deletion is: %d. Expecting 0.", len(table.Rows()))
 	}
 }
+
+func Test_TableGetChildPrimitiveOutOfRange(t *testing.T) {
+
+	table := TableWith{Rows: [][]Primitive{{CommandWith{Label: "r0c0"}.Make()}}}.Make()
+
+	for _, pkey := range []key.PKey{key.NewPKey(2, 1, 0), key.NewPKey(2, 0, 1), key.NewPKey(2, 0), key.NewPKey(0, 0), key.NewPKey(5)} {
+		if table.LocateNextDescendant(key.NewPKeyLocator(pkey)) != nil {
+			t.Errorf("LocateNextDescendant returned a child for invalid pkey %v.", pkey)
+		}
+	}
+}
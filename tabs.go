@@ -0,0 +1,240 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"github.com/prontogui/golib/key"
+)
+
+// A tabbed container showing one of several pages at a time, chosen by the user from a row
+// of tabs.
+type TabsWith struct {
+	Embodiment  string
+	SelectedTab int
+	Status      int
+	TabItems    []*Tab
+	Tag         string
+}
+
+// Creates a new Tabs using the supplied field assignments.
+func (w TabsWith) Make() *Tabs {
+	tabs := &Tabs{}
+	tabs.embodiment.Set(w.Embodiment)
+	tabs.selectedTab.Set(w.SelectedTab)
+	tabs.status.Set(w.Status)
+	tabs.SetTabItems(w.TabItems)
+	tabs.tag.Set(w.Tag)
+	return tabs
+}
+
+// A tabbed container showing one of several pages at a time, chosen by the user from a row
+// of tabs.  Each page is a Tab.
+//
+// The selected tab can be changed by the user or by calling SetSelectedTab.  When the user
+// changes it, TabChanged returns true.
+type Tabs struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	embodiment  StringField
+	selectedTab IntegerField
+	status      IntegerField
+	tabChanged  EventField
+	tabItems    Any1DField
+	tag         StringField
+}
+
+// Creates a new Tabs with a set of tabs, the first of which is selected.
+func NewTabs(tabs ...*Tab) *Tabs {
+	return TabsWith{TabItems: tabs}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (tabs *Tabs) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	tabs.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Embodiment, &tabs.embodiment},
+			{key.FKey_SelectedTab, &tabs.selectedTab},
+			{key.FKey_Status, &tabs.status},
+			{key.FKey_TabChanged, &tabs.tabChanged},
+			{key.FKey_TabItems, &tabs.tabItems},
+			{key.FKey_Tag, &tabs.tag},
+		}
+	})
+}
+
+// A non-recursive method to locate descendants by PKey.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (tabs *Tabs) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
+	if locator.NextIndex() != 0 {
+		return nil
+	}
+	return itemAt(tabs.tabItems.Get(), locator.NextIndex())
+}
+
+// Ingests an update from the app.  A selected tab that doesn't exist is not kept, and the
+// previous selection is sent back to the app instead without reporting the tab as changed.
+// This is used internally by this library and normally should not be called by users of the
+// library.
+func (tabs *Tabs) IngestUpdate(update map[any]any) error {
+	previous := tabs.selectedTab.Get()
+	if err := tabs.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	if selected := tabs.selectedTab.Get(); selected < 0 || selected >= len(tabs.tabItems.Get()) {
+		tabs.selectedTab.Set(previous)
+		tabs.tabChanged.revoke()
+	}
+	return nil
+}
+
+// Returns a string representation of this primitive:  the title of the selected tab.
+// Implements of fmt:Stringer interface.
+func (tabs *Tabs) String() string {
+	if tab := tabs.Selected(); tab != nil {
+		return tab.Title()
+	}
+	return ""
+}
+
+// Returns the tabs, each holding a page.
+func (tabs *Tabs) TabItems() []*Tab {
+	items := tabs.tabItems.Get()
+	tabItems := make([]*Tab, 0, len(items))
+	for _, item := range items {
+		if tab, ok := item.(*Tab); ok {
+			tabItems = append(tabItems, tab)
+		}
+	}
+	return tabItems
+}
+
+// Sets the tabs, each holding a page.
+func (tabs *Tabs) SetTabItems(tabItems []*Tab) *Tabs {
+	items := make([]Primitive, len(tabItems))
+	for i, tab := range tabItems {
+		items[i] = tab
+	}
+	tabs.tabItems.Set(items)
+	return tabs
+}
+
+// Sets the tabs (variadic argument list), each holding a page.
+func (tabs *Tabs) SetTabItemsVA(tabItems ...*Tab) *Tabs {
+	return tabs.SetTabItems(tabItems)
+}
+
+// Returns the index of the selected tab.
+func (tabs *Tabs) SelectedTab() int {
+	return tabs.selectedTab.Get()
+}
+
+// Sets the index of the selected tab.
+func (tabs *Tabs) SetSelectedTab(i int) *Tabs {
+	tabs.selectedTab.Set(i)
+	return tabs
+}
+
+// Returns the selected tab, or nil if there is none.
+func (tabs *Tabs) Selected() *Tab {
+	tab, _ := itemAt(tabs.tabItems.Get(), tabs.selectedTab.Get()).(*Tab)
+	return tab
+}
+
+// Returns true if the user selected a different tab during the current Wait cycle.
+func (tabs *Tabs) TabChanged() bool {
+	return tabs.tabChanged.Issued()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (tabs *Tabs) Embodiment() string {
+	return tabs.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (tabs *Tabs) SetEmbodiment(s string) *Tabs {
+	tabs.embodiment.Set(s)
+	return tabs
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (tabs *Tabs) Tag() string {
+	return tabs.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on.
+func (tabs *Tabs) SetTag(s string) *Tabs {
+	tabs.tag.Set(s)
+	return tabs
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Tabs) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *Tabs) SetStatus(i int) *Tabs {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *Tabs) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *Tabs) SetVisible(visible bool) *Tabs {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *Tabs) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *Tabs) SetEnabled(enabled bool) *Tabs {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *Tabs) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *Tabs) SetCollapsed(collapsed bool) *Tabs {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
@@ -0,0 +1,116 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_TabsAttachedFields(t *testing.T) {
+	tabs := &Tabs{}
+	tabs.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, tabs.PrimitiveBase, "Embodiment", "SelectedTab", "Status", "TabChanged", "TabItems", "Tag")
+}
+
+func Test_TabAttachedFields(t *testing.T) {
+	tab := &Tab{}
+	tab.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, tab.PrimitiveBase, "Icon", "PageItems", "Status", "Tag", "Title")
+}
+
+func Test_TabsMake(t *testing.T) {
+	first := NewTab("First", NewText("one"))
+	second := TabWith{Title: "Second", Icon: NewIcon("home")}.Make()
+	tabs := TabsWith{TabItems: []*Tab{first, second}, SelectedTab: 1}.Make()
+
+	if items := tabs.TabItems(); len(items) != 2 || items[0] != first || items[1] != second {
+		t.Error("could not initialize TabItems field")
+	}
+	if tabs.SelectedTab() != 1 || tabs.Selected() != second || tabs.String() != "Second" {
+		t.Error("could not initialize SelectedTab field")
+	}
+	if first.Title() != "First" || len(first.PageItems()) != 1 || first.Icon() != nil {
+		t.Error("could not initialize first tab")
+	}
+	if second.Icon() == nil {
+		t.Error("could not initialize Icon field")
+	}
+}
+
+func Test_TabsSetSelectedTab(t *testing.T) {
+	tabs := NewTabs(NewTab("First"), NewTab("Second"))
+	if tabs.SelectedTab() != 0 {
+		t.Error("expecting the first tab to be selected initially")
+	}
+	tabs.SetSelectedTab(1)
+	if tabs.Selected().Title() != "Second" {
+		t.Error("unable to select the second tab")
+	}
+	tabs.SetSelectedTab(5)
+	if tabs.Selected() != nil {
+		t.Error("expecting no selected tab for an index out of range")
+	}
+}
+
+func Test_TabsLocateNextDescendant(t *testing.T) {
+	icon := NewIcon("home")
+	text := NewText("one")
+	tab := TabWith{Title: "First", Icon: icon, PageItems: []Primitive{text}}.Make()
+	tabs := NewTabs(tab)
+
+	if tabs.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 0))) != tab {
+		t.Error("unable to locate tab")
+	}
+	if tabs.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0, 1))) != nil {
+		t.Error("expecting nil for a tab out of range")
+	}
+	if tab.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(0))) != icon {
+		t.Error("unable to locate icon")
+	}
+	if tab.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(1, 0))) != text {
+		t.Error("unable to locate page item")
+	}
+	if tab.LocateNextDescendant(key.NewPKeyLocator(key.NewPKey(2, 0))) != nil {
+		t.Error("expecting nil for an unknown field")
+	}
+}
+
+func Test_TabsIngestTabChanged(t *testing.T) {
+	ts := time.Now()
+	tabs := NewTabs(NewTab("First"), NewTab("Second"))
+	tabs.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if tabs.TabChanged() {
+		t.Error("tab changed before any update")
+	}
+	if err := tabs.IngestUpdate(map[any]any{"SelectedTab": uint64(1), "TabChanged": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !tabs.TabChanged() || tabs.SelectedTab() != 1 {
+		t.Error("unable to ingest a change of tab")
+	}
+}
+
+func Test_TabsIngestOutOfRange(t *testing.T) {
+	ts := time.Now()
+	tabs := NewTabs(NewTab("First"), NewTab("Second")).SetSelectedTab(1)
+	var sentBack bool
+	tabs.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack = true }, func() time.Time { return ts })
+
+	if err := tabs.IngestUpdate(map[any]any{"SelectedTab": uint64(2), "TabChanged": true}); err != nil {
+		t.Fatal(err)
+	}
+	if tabs.SelectedTab() != 1 || !sentBack {
+		t.Error("selected tab that doesn't exist was kept instead of sending back the previous one")
+	}
+	if tabs.TabChanged() {
+		t.Error("tab is reported as changed when the selected tab was put back")
+	}
+}
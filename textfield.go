@@ -7,36 +7,80 @@
 package golib
 
 import (
+	"time"
+	"unicode/utf8"
+
 	"github.com/prontogui/golib/key"
 )
 
 // An entry field that allows the user to enter text.
 type TextFieldWith struct {
-	Embodiment string
-	Status     int
-	Tag        string
-	TextEntry  string
+	Debounce     time.Duration
+	Embodiment   string
+	ErrorMessage string
+	MaxLength    int
+	MaxLines     int
+	Multiline    bool
+	Password     bool
+	Placeholder  string
+	ReadOnly     bool
+	Status       int
+	Tag          string
+	TextEntry    string
+	Validator    Validator
 }
 
 // Creates a new TextField using the supplied field assignments.
 func (w TextFieldWith) Make() *TextField {
 	textField := &TextField{}
+	textField.debounce = w.Debounce
 	textField.embodiment.Set(w.Embodiment)
+	textField.errorMessage.Set(w.ErrorMessage)
+	textField.maxLength.Set(w.MaxLength)
+	textField.maxLines.Set(w.MaxLines)
+	textField.multiline.Set(w.Multiline)
+	textField.password.Set(w.Password)
+	textField.placeholder.Set(w.Placeholder)
+	textField.readOnly.Set(w.ReadOnly)
 	textField.status.Set(w.Status)
 	textField.textEntry.Set(w.TextEntry)
 	textField.tag.Set(w.Tag)
+	textField.validator = w.Validator
 	return textField
 }
 
-// An entry field that allows the user to enter text.
+// An entry field that allows the user to enter text.  It can be a multi-line editor, such as
+// for notes, or obscure the text entered, such as for a password.
+//
+// When the user submits the text, typically by pressing Enter, Submitted returns true.
+//
+// The App updates the text entry as the user types, when Changing returns true, and again when
+// the user is done, such as by pressing Enter or leaving the field, when Committed returns true.
+// Set a debounce delay to have Wait return the field only once the user pauses typing.
 type TextField struct {
 	// Mix-in the common guts for primitives
 	PrimitiveBase
 
-	embodiment StringField
-	status     IntegerField
-	tag        StringField
-	textEntry  StringField
+	changing     EventField
+	committed    EventField
+	embodiment   StringField
+	errorMessage StringField
+	maxLength    IntegerField
+	maxLines     IntegerField
+	multiline    BooleanField
+	password     BooleanField
+	placeholder  StringField
+	readOnly     BooleanField
+	status       IntegerField
+	submitted    EventField
+	tag          StringField
+	textEntry    StringField
+
+	// Checks the text entered by the user.
+	validator Validator
+
+	// How long the user must pause typing before Wait returns the field, or 0 for no delay.
+	debounce time.Duration
 }
 
 // Create a new TextField with initial text.
@@ -51,14 +95,54 @@ func (txt *TextField) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction,
 
 	txt.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
 		return []FieldRef{
+			{key.FKey_Changing, &txt.changing},
+			{key.FKey_Committed, &txt.committed},
 			{key.FKey_Embodiment, &txt.embodiment},
+			{key.FKey_ErrorMessage, &txt.errorMessage},
+			{key.FKey_MaxLength, &txt.maxLength},
+			{key.FKey_MaxLines, &txt.maxLines},
+			{key.FKey_Multiline, &txt.multiline},
+			{key.FKey_Password, &txt.password},
+			{key.FKey_Placeholder, &txt.placeholder},
+			{key.FKey_ReadOnly, &txt.readOnly},
 			{key.FKey_Status, &txt.status},
+			{key.FKey_Submitted, &txt.submitted},
 			{key.FKey_Tag, &txt.tag},
 			{key.FKey_TextEntry, &txt.textEntry},
 		}
 	})
 }
 
+// Ingests an update from the app and validates the text entered by the user.  Text that is
+// too long is cut to MaxLength, and text that was changed while read-only is replaced by the
+// previous text.  The corrected text is sent back to the app.  This is used internally by this
+// library and normally should not be called by users of the library.
+func (txt *TextField) IngestUpdate(update map[any]any) error {
+	previous := txt.textEntry.Get()
+	if err := txt.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	txt.textEntry.Set(txt.correctEntry(previous))
+	txt.Validate()
+	return nil
+}
+
+// Returns the text entry, which was previous before the update, corrected to be allowed by the
+// MaxLength and ReadOnly fields.
+func (txt *TextField) correctEntry(previous string) string {
+	entry := txt.textEntry.Get()
+	if entry == previous {
+		return entry
+	}
+	if txt.readOnly.Get() {
+		return previous
+	}
+	if max := txt.maxLength.Get(); max > 0 && utf8.RuneCountInString(entry) > max {
+		return string([]rune(entry)[:max])
+	}
+	return entry
+}
+
 // Returns a string representation of this primitive:  the text entry.
 // Implements of fmt:Stringer interface.
 func (txt *TextField) String() string {
@@ -76,6 +160,154 @@ func (txt *TextField) SetTextEntry(s string) *TextField {
 	return txt
 }
 
+// Returns true if the user submitted the text during the current Wait cycle, typically by
+// pressing Enter.  A multi-line field is typically submitted with Ctrl+Enter.
+func (txt *TextField) Submitted() bool {
+	return txt.submitted.Issued()
+}
+
+// Returns true if the user changed the text during the current Wait cycle, such as by typing,
+// but isn't done with it yet.
+func (txt *TextField) Changing() bool {
+	return txt.changing.Issued()
+}
+
+// Returns true if the user was done changing the text during the current Wait cycle, such as
+// by pressing Enter or leaving the field.
+func (txt *TextField) Committed() bool {
+	return txt.committed.Issued()
+}
+
+// Returns how long the user must pause typing before Wait returns the field, or 0 if Wait
+// returns it for every change.
+func (txt *TextField) Debounce() time.Duration {
+	return txt.debounce
+}
+
+// Sets how long the user must pause typing before Wait returns the field, such as to run a
+// search once the user stops typing.  Wait then returns the field once the delay passes with
+// no further changes, with Changing returning true, or as soon as the text is committed.  Zero
+// returns the field for every change.
+//
+// Other primitives updated while the field is held back are returned first, and the field is
+// then returned in a Wait cycle of its own.
+func (txt *TextField) SetDebounce(d time.Duration) *TextField {
+	txt.debounce = d
+	return txt
+}
+
+// Returns how long to hold back the update just ingested before returning it from Wait.
+// Updates other than changes while typing are not held back.
+func (txt *TextField) debounceDelay() time.Duration {
+	if !txt.changing.Issued() || txt.committed.Issued() || txt.submitted.Issued() {
+		return 0
+	}
+	return txt.debounce
+}
+
+// Issues the changes held back for the current Wait cycle, once returned from Wait.
+func (txt *TextField) reissue() {
+	txt.changing.IngestValue(true)
+}
+
+// Returns true if the field is a multi-line editor.
+func (txt *TextField) Multiline() bool {
+	return txt.multiline.Get()
+}
+
+// Sets whether the field is a multi-line editor, such as for notes.
+func (txt *TextField) SetMultiline(b bool) *TextField {
+	txt.multiline.Set(b)
+	return txt
+}
+
+// Returns the number of lines shown by a multi-line field before it scrolls, or 0 if the App
+// decides.
+func (txt *TextField) MaxLines() int {
+	return txt.maxLines.Get()
+}
+
+// Sets the number of lines shown by a multi-line field before it scrolls, or 0 to let the App
+// decide.
+func (txt *TextField) SetMaxLines(n int) *TextField {
+	txt.maxLines.Set(n)
+	return txt
+}
+
+// Returns true if the text entered is obscured, such as for a password.
+func (txt *TextField) Password() bool {
+	return txt.password.Get()
+}
+
+// Sets whether the text entered is obscured, such as for a password.
+func (txt *TextField) SetPassword(b bool) *TextField {
+	txt.password.Set(b)
+	return txt
+}
+
+// Returns the hint shown in the field while it is empty.
+func (txt *TextField) Placeholder() string {
+	return txt.placeholder.Get()
+}
+
+// Sets the hint shown in the field while it is empty, such as "Search".
+func (txt *TextField) SetPlaceholder(s string) *TextField {
+	txt.placeholder.Set(s)
+	return txt
+}
+
+// Returns the maximum number of characters the user can enter, or 0 for no maximum.
+func (txt *TextField) MaxLength() int {
+	return txt.maxLength.Get()
+}
+
+// Sets the maximum number of characters the user can enter, or 0 for no maximum.
+func (txt *TextField) SetMaxLength(n int) *TextField {
+	txt.maxLength.Set(n)
+	return txt
+}
+
+// Returns true if the user can select and copy the text but not change it.
+func (txt *TextField) ReadOnly() bool {
+	return txt.readOnly.Get()
+}
+
+// Sets whether the user can select and copy the text but not change it.
+func (txt *TextField) SetReadOnly(b bool) *TextField {
+	txt.readOnly.Set(b)
+	return txt
+}
+
+// Returns the validator that checks the text entered by the user, or nil if there is none.
+func (txt *TextField) Validator() Validator {
+	return txt.validator
+}
+
+// Sets the validator that checks the text entered by the user.  The text is validated each
+// time the user changes it, and any error is shown in the ErrorMessage field.
+func (txt *TextField) SetValidator(v Validator) *TextField {
+	txt.validator = v
+	return txt
+}
+
+// Validates the text entry and shows any error in the ErrorMessage field.  Returns the error
+// from the validator, or nil if the text is valid or there is no validator.
+func (txt *TextField) Validate() error {
+	return validateEntry(txt.validator, txt.textEntry.Get(), &txt.errorMessage)
+}
+
+// Returns the error message shown to the user, or empty if there is none.
+func (txt *TextField) ErrorMessage() string {
+	return txt.errorMessage.Get()
+}
+
+// Sets the error message shown to the user.  This is normally set by Validate but can be
+// set directly, such as for errors found by other means.
+func (txt *TextField) SetErrorMessage(s string) *TextField {
+	txt.errorMessage.Set(s)
+	return txt
+}
+
 // Returns a JSON string specifying the embodiment to use for this primitive.
 func (txt *TextField) Embodiment() string {
 	return txt.embodiment.Get()
@@ -8,6 +8,7 @@ package golib
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prontogui/golib/key"
 )
@@ -15,7 +16,7 @@ import (
 func Test_TextFieldAttach(t *testing.T) {
 	txt := &TextField{}
 	txt.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
-	verifyAllFieldsAttached(t, txt.PrimitiveBase, "Embodiment", "Tag", "TextEntry")
+	verifyAllFieldsAttached(t, txt.PrimitiveBase, "Changing", "Committed", "Embodiment", "ErrorMessage", "MaxLength", "MaxLines", "Multiline", "Password", "Placeholder", "ReadOnly", "Status", "Submitted", "Tag", "TextEntry")
 }
 
 func Test_TextFieldMake(t *testing.T) {
@@ -56,3 +57,104 @@ func Test_TextFieldFieldSetting(t *testing.T) {
 		t.Error("could not set Content field")
 	}
 }
+
+func Test_TextFieldOptions(t *testing.T) {
+	txt := TextFieldWith{Multiline: true, MaxLines: 5, Placeholder: "Notes", MaxLength: 200}.Make()
+
+	if !txt.Multiline() || txt.MaxLines() != 5 {
+		t.Error("could not initialize Multiline and MaxLines fields")
+	}
+	if txt.Placeholder() != "Notes" || txt.MaxLength() != 200 {
+		t.Error("could not initialize Placeholder and MaxLength fields")
+	}
+	if txt.Password() || txt.ReadOnly() {
+		t.Error("expecting Password and ReadOnly to be false")
+	}
+
+	txt.SetPassword(true).SetReadOnly(true).SetMultiline(false).SetMaxLines(0)
+	if !txt.Password() || !txt.ReadOnly() || txt.Multiline() || txt.MaxLines() != 0 {
+		t.Error("could not set options")
+	}
+}
+
+func Test_TextFieldIngestSubmitted(t *testing.T) {
+	ts := time.Now()
+	txt := NewTextField("")
+	txt.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "abc"}); err != nil {
+		t.Fatal(err)
+	}
+	if txt.Submitted() {
+		t.Error("text was submitted by a keystroke")
+	}
+
+	ts = ts.Add(time.Second)
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "abcd", "Submitted": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !txt.Submitted() || txt.TextEntry() != "abcd" {
+		t.Error("unable to ingest submitted text")
+	}
+}
+
+func Test_TextFieldIngestTooLong(t *testing.T) {
+	txt := TextFieldWith{TextEntry: "abc", MaxLength: 3}.Make()
+	var sentBack bool
+	txt.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack = true }, getBogeyEventTimestampProvider())
+
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "xäbcd"}); err != nil {
+		t.Fatal(err)
+	}
+	if txt.TextEntry() != "xäb" || !sentBack {
+		t.Errorf("text that is too long was not cut and sent back.  Text is %q", txt.TextEntry())
+	}
+
+	sentBack = false
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "äbc"}); err != nil {
+		t.Fatal(err)
+	}
+	if txt.TextEntry() != "äbc" || sentBack {
+		t.Error("text of the maximum length was changed")
+	}
+}
+
+func Test_TextFieldIngestReadOnly(t *testing.T) {
+	txt := TextFieldWith{TextEntry: "abc", ReadOnly: true}.Make()
+	var sentBack bool
+	txt.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack = true }, getBogeyEventTimestampProvider())
+
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "xyz"}); err != nil {
+		t.Fatal(err)
+	}
+	if txt.TextEntry() != "abc" || !sentBack {
+		t.Error("read-only text was changed instead of sending back the previous text")
+	}
+}
+
+func Test_TextFieldIngestChangingAndCommitted(t *testing.T) {
+	ts := time.Now()
+	txt := TextFieldWith{Debounce: time.Second}.Make()
+	txt.PrepareForUpdates(key.NewPKey(0), nil, func() time.Time { return ts })
+
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "ab", "Changing": true}); err != nil {
+		t.Fatal(err)
+	}
+	if !txt.Changing() || txt.Committed() {
+		t.Error("unable to ingest a change")
+	}
+	if txt.debounceDelay() != time.Second {
+		t.Error("expecting a change to be debounced")
+	}
+
+	ts = ts.Add(time.Second)
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "abc", "Committed": true}); err != nil {
+		t.Fatal(err)
+	}
+	if txt.Changing() || !txt.Committed() {
+		t.Error("unable to ingest a commit")
+	}
+	if txt.debounceDelay() != 0 {
+		t.Error("expecting a commit not to be debounced")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// A field holding a time, which is sent to the App as a string formatted using a layout.  A
+// zero time is sent as an empty string.
+type TimeField struct {
+	FieldBase
+	t        time.Time
+	layout   string
+	location *time.Location
+}
+
+func (f *TimeField) Get() time.Time {
+	return f.t
+}
+
+func (f *TimeField) Set(t time.Time) {
+	if t.Equal(f.t) && t.Location() == f.t.Location() {
+		return
+	}
+	f.t = t
+	f.OnSet(false)
+}
+
+// Sets the layout used to format the time for the App, as used by time.Format, and the
+// location used for times received from the App.  The defaults are time.RFC3339Nano and
+// time.Local.
+func (f *TimeField) setFormat(layout string, loc *time.Location) {
+	f.layout = layout
+	f.location = loc
+}
+
+func (f *TimeField) getLayout() string {
+	if f.layout == "" {
+		return time.RFC3339Nano
+	}
+	return f.layout
+}
+
+func (f *TimeField) getLocation() *time.Location {
+	if f.location == nil {
+		return time.Local
+	}
+	return f.location
+}
+
+func (f *TimeField) PrepareForUpdates(fkey key.FKey, pkey key.PKey, fieldPKeyIndex int, onset key.OnSetFunction, etsprovider EventTimestampProvider) (isContainer bool) {
+	f.StashUpdateInfo(fkey, pkey, fieldPKeyIndex, onset, etsprovider)
+	return false
+}
+
+func (f *TimeField) EgestValue() any {
+	if f.t.IsZero() {
+		return ""
+	}
+	return f.t.In(f.getLocation()).Format(f.getLayout())
+}
+
+func (f *TimeField) IngestValue(value any) error {
+
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			f.t = time.Time{}
+			return nil
+		}
+		t, err := time.ParseInLocation(f.getLayout(), v, f.getLocation())
+		if err != nil {
+			return err
+		}
+		f.t = t.In(f.getLocation())
+		return nil
+	case time.Time:
+		f.t = v.In(f.getLocation())
+		return nil
+	}
+
+	return errors.New("unable to convert value (any) to field value")
+}
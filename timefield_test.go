@@ -0,0 +1,91 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_TimeSetAndGet(t *testing.T) {
+	f := TimeField{}
+	tm := time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	f.Set(tm)
+
+	if !f.Get().Equal(tm) {
+		t.Fatal("cannot set time and get the same value back.")
+	}
+}
+
+func Test_TimePrepareForUpdates(t *testing.T) {
+	f := TimeField{}
+
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	verifyFieldPreppedForUpdate(t, &f.FieldBase)
+
+	f.Set(time.Now())
+
+	if !testOnsetCalled {
+		t.Error("onset was not called")
+	}
+}
+
+func Test_TimeEgestValue(t *testing.T) {
+	f := TimeField{}
+
+	if f.EgestValue() != "" {
+		t.Error("zero time is not egested as an empty string")
+	}
+
+	f.Set(time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC))
+	if v := f.EgestValue(); v != "2026-03-14T15:09:26Z" {
+		t.Errorf("egested value is %v", v)
+	}
+
+	f.setFormat("2006-01-02", time.UTC)
+	if v := f.EgestValue(); v != "2026-03-14" {
+		t.Errorf("egested value is %v", v)
+	}
+}
+
+func Test_TimeIngestUpdate(t *testing.T) {
+	f := TimeField{}
+	f.setFormat("15:04", time.UTC)
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	verifyIngestUpdateSuccessful(t, f.IngestValue("08:45"), func() bool {
+		h, m, _ := f.Get().Clock()
+		return h == 8 && m == 45 && f.Get().Location() == time.UTC
+	})
+	verifyIngestUpdateSuccessful(t, f.IngestValue(""), func() bool { return f.Get().IsZero() })
+
+	if err := f.IngestValue("8:45pm"); err == nil {
+		t.Error("expecting an error for a time in the wrong layout")
+	}
+}
+
+func Test_TimeIngestUpdateInvalid(t *testing.T) {
+	f := TimeField{}
+	err := f.IngestValue(42)
+	verifyIngestUpdateInvalid(t, err)
+}
+
+func Test_TimeSetSameValue(t *testing.T) {
+	f := TimeField{}
+	f.Set(time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC))
+	f.PrepareForUpdates(10, key.NewPKey(50), 0, getTestOnsetFunc(), getBogeyEventTimestampProvider())
+
+	f.Set(time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC))
+
+	if testOnsetCalled {
+		t.Error("onset was called even though the value didn't change")
+	}
+}
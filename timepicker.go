@@ -0,0 +1,258 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+// A field for choosing a time of day.
+type TimePickerWith struct {
+	Earliest   time.Time
+	Embodiment string
+	Latest     time.Time
+	Location   *time.Location
+	Status     int
+	Tag        string
+	Time       time.Time
+}
+
+// Creates a new TimePicker using the supplied field assignments.
+func (w TimePickerWith) Make() *TimePicker {
+	tp := &TimePicker{}
+	tp.SetLocation(w.Location)
+	tp.SetEarliest(w.Earliest)
+	tp.embodiment.Set(w.Embodiment)
+	tp.SetLatest(w.Latest)
+	tp.status.Set(w.Status)
+	tp.tag.Set(w.Tag)
+	tp.SetTime(w.Time)
+	return tp
+}
+
+// A field for choosing a time of day.
+type TimePicker struct {
+	// Mix-in the common guts for primitives
+	PrimitiveBase
+
+	earliest   TimeField
+	embodiment StringField
+	latest     TimeField
+	status     IntegerField
+	tag        StringField
+	time       TimeField
+	timeZone   StringField
+
+	// The location of the times held by this primitive.
+	location *time.Location
+}
+
+// Creates a new TimePicker with an initial time.
+func NewTimePicker(t time.Time) *TimePicker {
+	return TimePickerWith{Time: t}.Make()
+}
+
+// Prepares the primitive for tracking pending updates to send to the app and
+// for injesting updates from the app.  This is used internally by this library
+// and normally should not be called by users of the library.
+func (tp *TimePicker) PrepareForUpdates(pkey key.PKey, onset key.OnSetFunction, etsprovider EventTimestampProvider) {
+
+	tp.setFieldFormats()
+
+	tp.InternalPrepareForUpdates(pkey, onset, etsprovider, func() []FieldRef {
+		return []FieldRef{
+			{key.FKey_Earliest, &tp.earliest},
+			{key.FKey_Embodiment, &tp.embodiment},
+			{key.FKey_Latest, &tp.latest},
+			{key.FKey_Status, &tp.status},
+			{key.FKey_Tag, &tp.tag},
+			{key.FKey_Time, &tp.time},
+			{key.FKey_TimeZone, &tp.timeZone},
+		}
+	})
+}
+
+// Ingests an update from the app.  A time outside of the Earliest and Latest bounds is
+// not kept, and the previous time is sent back to the app instead.  This is used internally
+// by this library and normally should not be called by users of the library.
+func (tp *TimePicker) IngestUpdate(update map[any]any) error {
+	previous := tp.time.Get()
+	if err := tp.PrimitiveBase.IngestUpdate(update); err != nil {
+		return err
+	}
+	if !inTimeBounds(tp.time.Get(), tp.earliest.Get(), tp.latest.Get()) {
+		tp.time.Set(previous)
+	}
+	return nil
+}
+
+// Sets the layout and location of the time fields.
+func (tp *TimePicker) setFieldFormats() {
+	loc := tp.Location()
+	tp.earliest.setFormat(timeLayout, loc)
+	tp.latest.setFormat(timeLayout, loc)
+	tp.time.setFormat(timeLayout, loc)
+}
+
+// Returns a string representation of this primitive:  the time, or empty if none is chosen.
+// Implements of fmt:Stringer interface.
+func (tp *TimePicker) String() string {
+	return formatTime(tp.time.Get(), timeLayout)
+}
+
+// Returns the time chosen by the user, or a zero time if none is chosen.  The time is on January 1 of year
+// 0 in the primitive's Location, so only its clock is meaningful.
+func (tp *TimePicker) Time() time.Time {
+	return tp.time.Get()
+}
+
+// Sets the time chosen by the user, or a zero time if none is chosen.  Only the time of day of t in the
+// primitive's Location is kept, to the second.
+func (tp *TimePicker) SetTime(t time.Time) *TimePicker {
+	tp.time.Set(normalizeTime(t, tp.Location()))
+	return tp
+}
+
+// Returns the earliest time that can be chosen, or a zero time if there is no limit.
+func (tp *TimePicker) Earliest() time.Time {
+	return tp.earliest.Get()
+}
+
+// Sets the earliest time that can be chosen, or a zero time if there is no limit.
+func (tp *TimePicker) SetEarliest(t time.Time) *TimePicker {
+	tp.earliest.Set(normalizeTime(t, tp.Location()))
+	return tp
+}
+
+// Returns the latest time that can be chosen, or a zero time if there is no limit.
+func (tp *TimePicker) Latest() time.Time {
+	return tp.latest.Get()
+}
+
+// Sets the latest time that can be chosen, or a zero time if there is no limit.
+func (tp *TimePicker) SetLatest(t time.Time) *TimePicker {
+	tp.latest.Set(normalizeTime(t, tp.Location()))
+	return tp
+}
+
+// Returns the location of the times held by this primitive.  The default is time.Local.
+func (tp *TimePicker) Location() *time.Location {
+	if tp.location == nil {
+		return time.Local
+	}
+	return tp.location
+}
+
+// Sets the location of the times held by this primitive, or nil for time.Local.  The
+// App is told the name of its time zone using the TimeZone field.
+func (tp *TimePicker) SetLocation(loc *time.Location) *TimePicker {
+	tp.location = loc
+	if loc == nil {
+		tp.timeZone.Set("")
+	} else {
+		tp.timeZone.Set(loc.String())
+	}
+	tp.setFieldFormats()
+	tp.SetEarliest(tp.earliest.Get())
+	tp.SetLatest(tp.latest.Get())
+	tp.SetTime(tp.time.Get())
+	return tp
+}
+
+// Returns the name of the time zone used by the App, such as "America/New_York", or empty if
+// the App uses its own time zone.  This is set by SetLocation.
+func (tp *TimePicker) TimeZone() string {
+	return tp.timeZone.Get()
+}
+
+// Returns a JSON string specifying the embodiment to use for this primitive.
+func (tp *TimePicker) Embodiment() string {
+	return tp.embodiment.Get()
+}
+
+// Sets a JSON string specifying the embodiment to use for this primitive.
+func (tp *TimePicker) SetEmbodiment(s string) *TimePicker {
+	tp.embodiment.Set(s)
+	return tp
+}
+
+// Returns an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using TimePickers as Table cells.
+func (tp *TimePicker) Tag() string {
+	return tp.tag.Get()
+}
+
+// Sets an optional and arbitrary string to keep with this primitive.  This is useful for
+// identification later on, such as using TimePickers as Table cells.
+func (tp *TimePicker) SetTag(s string) *TimePicker {
+	tp.tag.Set(s)
+	return tp
+}
+
+// Returns the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *TimePicker) Status() int {
+	return p.status.Get()
+}
+
+// Sets the status of the primitive: 0 = visible and enabled,  1 = visible and disabled,
+// 2 = hidden and disabled, 3 = collapsed and disabled.
+func (p *TimePicker) SetStatus(i int) *TimePicker {
+	p.status.Set(i)
+	return p
+}
+
+// Returns the visibility of the primitive.  This is derived from the Status field.
+func (p *TimePicker) Visible() bool {
+	status := p.status.Get()
+	return status == 0 || status == 1
+}
+
+// Sets the visibility of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 2 (hidden).
+func (p *TimePicker) SetVisible(visible bool) *TimePicker {
+	if visible {
+		p.status.Set(0)
+	} else {
+		p.status.Set(2)
+	}
+	return p
+}
+
+// Returns the enabled status of the primitive.  This is derived from the Status field.
+func (p *TimePicker) Enabled() bool {
+	return p.status.Get() == 0
+}
+
+// Sets the enabled status of the primitive.  Setting this to true will set Status to 0 (visible & enabled)
+// and setting this to false will set Status to 1 (disabled).
+func (p *TimePicker) SetEnabled(enabled bool) *TimePicker {
+	if enabled {
+		p.status.Set(0)
+	} else {
+		p.status.Set(1)
+	}
+	return p
+}
+
+// Returns the collapsed status of the primitive.  This is derived from the Status field.
+func (p *TimePicker) Collapsed() bool {
+	return p.status.Get() == 3
+}
+
+// Sets the collapsed status of the primitive.  Setting this to true will set Status to 3 (collapsed)
+// and setting this to false will set Status to 0 (visible & enabled).
+func (p *TimePicker) SetCollapsed(collapsed bool) *TimePicker {
+	if collapsed {
+		p.status.Set(3)
+	} else {
+		p.status.Set(0)
+	}
+	return p
+}
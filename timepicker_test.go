@@ -0,0 +1,60 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prontogui/golib/key"
+)
+
+func Test_TimePickerAttach(t *testing.T) {
+	tp := &TimePicker{}
+	tp.PrepareForUpdates(key.NewPKey(), nil, getBogeyEventTimestampProvider())
+	verifyAllFieldsAttached(t, tp.PrimitiveBase, "Earliest", "Embodiment", "Latest", "Status", "Tag", "Time", "TimeZone")
+}
+
+func Test_TimePickerSetTime(t *testing.T) {
+	tp := NewTimePicker(time.Date(2026, 10, 19, 9, 30, 15, 500, time.UTC)).SetLocation(time.UTC)
+
+	if tp.String() != "09:30:15" {
+		t.Errorf("string is %q", tp.String())
+	}
+	if h, m, s := tp.Time().Clock(); h != 9 || m != 30 || s != 15 || tp.Time().Year() != 0 {
+		t.Errorf("time is %v.  Expecting only the time of day to be kept", tp.Time())
+	}
+
+	tp.SetLocation(time.FixedZone("UTC-5", -5*60*60))
+	if tp.String() != "04:30:15" {
+		t.Errorf("string is %q after changing the location", tp.String())
+	}
+}
+
+func Test_TimePickerIngest(t *testing.T) {
+	tp := TimePickerWith{
+		Earliest: time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC),
+		Latest:   time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC),
+		Location: time.UTC,
+	}.Make()
+	var sentBack bool
+	tp.PrepareForUpdates(key.NewPKey(0), func(pkey key.PKey, fkey key.FKey, structural bool) { sentBack = true }, getBogeyEventTimestampProvider())
+
+	if err := tp.IngestUpdate(map[any]any{"Time": "12:15:00"}); err != nil {
+		t.Fatal(err)
+	}
+	if tp.String() != "12:15:00" {
+		t.Errorf("time is %v", tp.Time())
+	}
+
+	if err := tp.IngestUpdate(map[any]any{"Time": "18:00:00"}); err != nil {
+		t.Fatal(err)
+	}
+	if tp.String() != "12:15:00" || !sentBack {
+		t.Error("time after the latest allowed was stored instead of sending back the previous time")
+	}
+}
@@ -82,7 +82,7 @@ func (tri *Tristate) LocateNextDescendant(locator *key.PKeyLocator) Primitive {
 		return tri.LabelItem()
 	}
 
-	panic("cannot locate descendent using a pkey that we assumed was valid")
+	return nil
 }
 
 // Returns a string representation of this primitive:  the label.
@@ -0,0 +1,245 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Validator checks a value entered by the user.  It returns nil if the value is valid,
+// otherwise an error whose message is shown to the user next to the field.
+//
+// Apart from Required, the validators provided here accept an empty value so they can be
+// used with optional fields.  Combine them with Required using AllOf when a value must be
+// entered.
+type Validator func(value string) error
+
+// Returns a Validator that requires a value other than whitespace.
+func Required() Validator {
+	return func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return errors.New("a value is required")
+		}
+		return nil
+	}
+}
+
+// Returns a Validator that requires the whole value to match a regular expression.  It panics
+// if the expression cannot be parsed, like regexp.MustCompile.
+func Pattern(expr string) Validator {
+	re := regexp.MustCompile(`^(?:` + expr + `)$`)
+	return func(value string) error {
+		if value != "" && !re.MatchString(value) {
+			return errors.New("the value is not in the expected format")
+		}
+		return nil
+	}
+}
+
+// Returns a Validator that requires the value to have at least min and at most max characters.
+// A max of 0 or less means there is no maximum.
+func Length(min, max int) Validator {
+	return func(value string) error {
+		n := utf8.RuneCountInString(value)
+		switch {
+		case n == 0:
+			return nil
+		case n < min:
+			return fmt.Errorf("must have at least %d characters", min)
+		case max > 0 && n > max:
+			return fmt.Errorf("must have at most %d characters", max)
+		}
+		return nil
+	}
+}
+
+// Returns a Validator that requires the value to be a number from min to max inclusive.
+func NumericRange(min, max float64) Validator {
+	return func(value string) error {
+		if value == "" {
+			return nil
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return errors.New("must be a number")
+		}
+		if n < min || n > max {
+			return fmt.Errorf("must be from %v to %v", min, max)
+		}
+		return nil
+	}
+}
+
+// Returns a Validator that requires the value to be an email address, such as
+// "gopher@example.com".
+func Email() Validator {
+	return func(value string) error {
+		if value == "" {
+			return nil
+		}
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Name != "" || addr.Address != value {
+			return errors.New("must be an email address")
+		}
+		return nil
+	}
+}
+
+// Returns a Validator that checks the value with each of the validators in turn, and returns
+// the first error found.
+func AllOf(validators ...Validator) Validator {
+	return func(value string) error {
+		for _, v := range validators {
+			if v == nil {
+				continue
+			}
+			if err := v(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Returns a Validator that reports message instead of the error returned by validator.
+func WithMessage(validator Validator, message string) Validator {
+	return func(value string) error {
+		if validator(value) != nil {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// Runs the validator on a value and shows the error message, if any, in errorMessage.  The
+// error message is left alone if there is no validator.
+func validateEntry(validator Validator, value string, errorMessage *StringField) error {
+	if validator == nil {
+		return nil
+	}
+	err := validator(value)
+	if err != nil {
+		errorMessage.Set(err.Error())
+	} else {
+		errorMessage.Set("")
+	}
+	return err
+}
+
+// A primitive whose value entered by the user can be validated, such as a TextField,
+// NumericField, or Choice.
+type Validatable interface {
+	Primitive
+
+	// Validates the value and shows any error in the App.  Returns the error, or nil if the
+	// value is valid.
+	Validate() error
+}
+
+// ValidationError describes a primitive with a value that is not valid.  It is reported by
+// Form.Validate.
+type ValidationError struct {
+	// The primitive with the value that is not valid.
+	Primitive Validatable
+
+	// The error returned by the primitive's Validator.
+	Err error
+}
+
+// Returns the error message.  Implements the error interface.
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+// Returns the error returned by the primitive's Validator.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// A Form validates all of the primitives contained within a set of primitives, such as the
+// Groups, Frames, and Lists making up a GUI.
+type Form struct {
+	items []Primitive
+}
+
+// Creates a new Form containing the given primitives and their descendants.
+func NewForm(items ...Primitive) *Form {
+	return &Form{items: items}
+}
+
+// Validates every Validatable primitive within the form, showing any errors in the App, and
+// returns an error for each one that is not valid.  Returns nil if all are valid.
+func (f *Form) Validate() []*ValidationError {
+	var invalid []*ValidationError
+	for _, item := range f.items {
+		walkPrimitives(item, func(p Primitive) {
+			if v, ok := p.(Validatable); ok {
+				if err := v.Validate(); err != nil {
+					invalid = append(invalid, &ValidationError{Primitive: v, Err: err})
+				}
+			}
+		})
+	}
+	return invalid
+}
+
+// Returns true if every Validatable primitive within the form is valid.
+func (f *Form) Valid() bool {
+	return len(f.Validate()) == 0
+}
+
+// Calls visit for p and each of its descendants, in order.
+func walkPrimitives(p Primitive, visit func(Primitive)) {
+	if p == nil {
+		return
+	}
+	visit(p)
+	for _, child := range containedPrimitives(p) {
+		walkPrimitives(child, visit)
+	}
+}
+
+// Returns the primitives contained within p that can hold content entered by the user, or a
+// dialog.  These come from the primitive's accessors, so they are known before p is prepared
+// for updates.
+func containedPrimitives(p Primitive) []Primitive {
+	switch p := p.(type) {
+	case *Group:
+		return p.GroupItems()
+	case *Frame:
+		return p.FrameItems()
+	case *List:
+		return p.ListItems()
+	case *Dialog:
+		return p.DialogItems()
+	case *Tab:
+		return p.PageItems()
+	case *Tabs:
+		var tabs []Primitive
+		for _, tab := range p.TabItems() {
+			tabs = append(tabs, tab)
+		}
+		return tabs
+	case *Table:
+		var cells []Primitive
+		for _, row := range p.Rows() {
+			cells = append(cells, row...)
+		}
+		return cells
+	case *Card:
+		return []Primitive{p.LeadingItem(), p.MainItem(), p.SubItem(), p.TrailingItem()}
+	case *FolderItem:
+		return []Primitive{p.Item()}
+	}
+	return nil
+}
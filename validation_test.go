@@ -0,0 +1,192 @@
+// Copyright 2024-2026 ProntoGUI, LLC
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+//
+// ProntoGUI™ is a trademark of ProntoGUI, LLC
+
+package golib
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prontogui/golib/key"
+)
+
+func verifyValid(t *testing.T, v Validator, values ...string) {
+	t.Helper()
+	for _, value := range values {
+		if err := v(value); err != nil {
+			t.Errorf("value %q is not valid: %v", value, err)
+		}
+	}
+}
+
+func verifyInvalid(t *testing.T, v Validator, values ...string) {
+	t.Helper()
+	for _, value := range values {
+		if v(value) == nil {
+			t.Errorf("value %q is valid.  Expecting an error", value)
+		}
+	}
+}
+
+func Test_ValidatorRequired(t *testing.T) {
+	verifyValid(t, Required(), "a", " b ")
+	verifyInvalid(t, Required(), "", "  ")
+}
+
+func Test_ValidatorPattern(t *testing.T) {
+	v := Pattern(`[A-Z]{3}-\d+`)
+	verifyValid(t, v, "", "ABC-1", "XYZ-123")
+	verifyInvalid(t, v, "abc-1", "ABC-", "xABC-1", "ABC-1x")
+}
+
+func Test_ValidatorLength(t *testing.T) {
+	verifyValid(t, Length(2, 4), "", "ab", "abcd", "äöüß")
+	verifyInvalid(t, Length(2, 4), "a", "abcde")
+	verifyValid(t, Length(1, 0), "a", "a very long value indeed")
+}
+
+func Test_ValidatorNumericRange(t *testing.T) {
+	v := NumericRange(-1, 10.5)
+	verifyValid(t, v, "", "-1", "0", "10.5", " 3 ")
+	verifyInvalid(t, v, "-1.01", "11", "ten", "1,000")
+}
+
+func Test_ValidatorEmail(t *testing.T) {
+	verifyValid(t, Email(), "", "gopher@example.com", "a.b+c@sub.example.org")
+	verifyInvalid(t, Email(), "gopher", "gopher@", "Gopher <gopher@example.com>", " gopher@example.com")
+}
+
+func Test_ValidatorAllOf(t *testing.T) {
+	v := AllOf(Required(), nil, Length(0, 3))
+	verifyValid(t, v, "abc")
+	verifyInvalid(t, v, "", "abcd")
+
+	if err := v(""); err == nil || err.Error() != "a value is required" {
+		t.Errorf("error is %v.  Expecting the error from the first failing validator", err)
+	}
+}
+
+func Test_ValidatorWithMessage(t *testing.T) {
+	v := WithMessage(Email(), "please enter your email address")
+	verifyValid(t, v, "gopher@example.com")
+
+	if err := v("gopher"); err == nil || err.Error() != "please enter your email address" {
+		t.Errorf("error is %v.  Expecting the given message", err)
+	}
+}
+
+func Test_ValidateShowsErrorMessage(t *testing.T) {
+	txt := NewTextField("").SetValidator(Required())
+
+	if txt.Validate() == nil {
+		t.Fatal("empty text is valid.  Expecting an error")
+	}
+	if txt.ErrorMessage() != "a value is required" {
+		t.Errorf("error message is %q", txt.ErrorMessage())
+	}
+
+	txt.SetTextEntry("something")
+	if err := txt.Validate(); err != nil {
+		t.Fatalf("text is not valid: %v", err)
+	}
+	if txt.ErrorMessage() != "" {
+		t.Errorf("error message is %q.  Expecting it to be cleared", txt.ErrorMessage())
+	}
+}
+
+func Test_ValidateWithoutValidator(t *testing.T) {
+	txt := NewTextField("").SetErrorMessage("already taken")
+
+	if err := txt.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if txt.ErrorMessage() != "already taken" {
+		t.Error("error message was changed without a validator")
+	}
+}
+
+func Test_IngestValidates(t *testing.T) {
+	txt := NewTextField("").SetValidator(Length(3, 0))
+	nf := NewNumericField("").SetValidator(NumericRange(0, 10))
+	choice := NewChoice("", "red", "green").SetValidator(Required())
+
+	txt.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+	nf.PrepareForUpdates(key.NewPKey(1), nil, getBogeyEventTimestampProvider())
+	choice.PrepareForUpdates(key.NewPKey(2), nil, getBogeyEventTimestampProvider())
+
+	if err := txt.IngestUpdate(map[any]any{"TextEntry": "ab"}); err != nil {
+		t.Fatal(err)
+	}
+	if txt.TextEntry() != "ab" || txt.ErrorMessage() == "" {
+		t.Error("invalid text was not stored with an error message")
+	}
+
+	if err := nf.IngestUpdate(map[any]any{"NumericEntry": "11"}); err != nil {
+		t.Fatal(err)
+	}
+	if nf.ErrorMessage() != "must be from 0 to 10" {
+		t.Errorf("error message is %q", nf.ErrorMessage())
+	}
+
+	if err := choice.IngestUpdate(map[any]any{"Choice": "red"}); err != nil {
+		t.Fatal(err)
+	}
+	if choice.ErrorMessage() != "" {
+		t.Errorf("error message is %q.  Expecting none", choice.ErrorMessage())
+	}
+}
+
+func Test_FormValidate(t *testing.T) {
+	name := NewTextField("").SetValidator(Required())
+	email := NewTextField("gopher").SetValidator(Email())
+	age := NewNumericField("42").SetValidator(NumericRange(0, 150))
+	color := NewChoice("", "red").SetValidator(Required())
+	note := NewTextField("")
+
+	grp := NewGroup(name, NewFrame(email, age), NewText("label"), color, note)
+	grp.PrepareForUpdates(key.NewPKey(0), nil, getBogeyEventTimestampProvider())
+
+	form := NewForm(grp)
+	invalid := form.Validate()
+
+	if len(invalid) != 3 {
+		t.Fatalf("%d invalid fields reported.  Expecting 3", len(invalid))
+	}
+	if invalid[0].Primitive != name || invalid[1].Primitive != email || invalid[2].Primitive != color {
+		t.Error("invalid fields were not reported in order")
+	}
+	if email.ErrorMessage() != "must be an email address" {
+		t.Errorf("error message is %q", email.ErrorMessage())
+	}
+
+	var ve *ValidationError
+	if !errors.As(error(invalid[1]), &ve) || ve.Error() != "must be an email address" {
+		t.Error("ValidationError does not describe the error")
+	}
+
+	name.SetTextEntry("Gopher")
+	email.SetTextEntry("gopher@example.com")
+	color.SetChoice("red")
+
+	if !form.Valid() {
+		t.Error("form is not valid after correcting the fields")
+	}
+	if email.ErrorMessage() != "" {
+		t.Error("error message was not cleared")
+	}
+}
+
+func Test_FormValidateBeforeSetGUI(t *testing.T) {
+	name := NewTextField("").SetValidator(Required())
+	age := NewNumericField("200").SetValidator(NumericRange(0, 150))
+	tabs := NewTabs(NewTab("Person", name), NewTab("Details", NewFrame(NewList(age))))
+
+	invalid := NewForm(NewGroup(tabs)).Validate()
+
+	if len(invalid) != 2 || invalid[0].Primitive != name || invalid[1].Primitive != age {
+		t.Fatalf("invalid fields reported are %v.  Expecting the name and age", invalid)
+	}
+}